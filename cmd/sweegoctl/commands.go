@@ -0,0 +1,254 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// cli contains the flags shared by all commands.
+type cli struct {
+	flags       *flag.FlagSet
+	output      string
+	credentials sweego.SweegoCredentials
+	verbose     bool
+}
+
+var commands = map[string]func(cli *cli, args []string) error{
+	"domains list":   domainsList,
+	"domains get":    domainsGet,
+	"domains check":  domainsCheck,
+	"domains delete": domainsDelete,
+	"tracking set":   trackingSet,
+	"records export": recordsExport,
+
+	"terraform generate": terraformGenerate,
+}
+
+// parse parses flags and positional arguments, which may be mixed (e.g. `get <uuid> -o json`).
+// It fails unless exactly the given number of positional arguments is passed.
+func (cli *cli) parse(args []string, positional int) ([]string, error) {
+	arguments := []string{}
+	for {
+		if err := cli.flags.Parse(args); err != nil {
+			return nil, err
+		}
+		if cli.flags.NArg() == 0 {
+			break
+		}
+		arguments = append(arguments, cli.flags.Arg(0))
+		args = cli.flags.Args()[1:]
+	}
+
+	if len(arguments) != positional {
+		return nil, fmt.Errorf("%s: expected %d argument(s), got %d", cli.flags.Name(), positional, len(arguments))
+	}
+	if cli.output != outputTable && cli.output != outputJson {
+		return nil, fmt.Errorf("unknown output format %#v, expected %s or %s", cli.output, outputTable, outputJson)
+	}
+	return arguments, nil
+}
+
+// api creates the API client using the credentials of flags, environment variables or the credentials file.
+func (cli *cli) api() (*sweego.SweegoApi, error) {
+	credentials, err := sweego.LoadCredentials(cli.credentials)
+	if err != nil {
+		return nil, err
+	}
+	if credentials.ApiKey == "" || credentials.ClientId == "" {
+		return nil, fmt.Errorf("API key and client ID are required. Use -api-key and -client-id, %s and %s or %s", sweego.EnvApiKey, sweego.EnvClientId, sweego.CredentialsFile())
+	}
+
+	options := []sweego.SweegoApiOption{
+		sweego.WithUserAgent("sweegoctl"),
+		sweego.WithRetry(3, time.Second),
+		sweego.WithLogger(discardLogger{}),
+	}
+	if cli.verbose {
+		options = append(options, sweego.WithLogger(sweego.GolangLogger{}))
+	}
+	if credentials.BaseUrl != "" {
+		options = append(options, sweego.WithBaseUrl(credentials.BaseUrl))
+	}
+	return sweego.NewSweegoApi(credentials.ApiKey, credentials.ClientId, options...), nil
+}
+
+func domainsList(cli *cli, args []string) error {
+	if _, err := cli.parse(args, 0); err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	domains, err := api.ListDomains("")
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{"UUID", "DOMAIN", "VERIFIED", "OPEN TRACKING", "CLICK TRACKING", "LAST VERIFICATION"}}
+	for _, domain := range domains {
+		table = append(table, []string{
+			domain.Uuid,
+			domain.Domain,
+			strconv.FormatBool(domain.IsVerified),
+			strconv.FormatBool(domain.TrackingOpenEnabled),
+			strconv.FormatBool(domain.TrackingClickEnabled),
+			domain.LastVerificationDate,
+		})
+	}
+	return cli.print(domains, table)
+}
+
+func domainsGet(cli *cli, args []string) error {
+	arguments, err := cli.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	domain, err := api.GetDomain("", arguments[0])
+	if err != nil {
+		return err
+	}
+
+	// Records are shown as returned by sweego (unlike records export), in order to debug verification problems
+	table := [][]string{{"PURPOSE", "TYPE", "NAME", "DATA", "VERIFIED"}}
+	addRecord := func(purpose string, record sweego.SweegoDomainRecord) {
+		if record.Data != "" {
+			table = append(table, []string{purpose, record.Type, record.Name, record.Data, strconv.FormatBool(record.Verified)})
+		}
+	}
+	addRecord("domain", domain.DomainRecord)
+	addRecord("dkim", domain.DkimRecord)
+	addRecord("dkim_next", domain.NextDkimRecord)
+	addRecord("dmarc", domain.DmarcRecord)
+	addRecord("tracking", domain.TrackingRecord)
+	for i, record := range domain.InboundRecordList {
+		addRecord(fmt.Sprintf("inbound_%d", i), record)
+	}
+
+	if cli.output == outputTable {
+		fmt.Printf("%s (%s), verified: %t, open tracking: %t, click tracking: %t, tracking subdomain: %s, https: %t\n\n",
+			domain.Domain, domain.Uuid, domain.IsVerified, domain.TrackingOpenEnabled, domain.TrackingClickEnabled, domain.TrackingSubdomain, domain.TrackingHttpsEnabled)
+	}
+	return cli.print(domain, table)
+}
+
+func domainsCheck(cli *cli, args []string) error {
+	arguments, err := cli.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	check, err := api.Check("", arguments[0])
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{"RECORD", "VERIFIED", "ERROR"}}
+	for _, result := range check.Records() {
+		table = append(table, []string{result.Record, strconv.FormatBool(result.Verified), result.ErrorString})
+	}
+	return cli.print(check, table)
+}
+
+func domainsDelete(cli *cli, args []string) error {
+	arguments, err := cli.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	if err := api.DeleteDomain("", arguments[0]); err != nil {
+		return err
+	}
+	return cli.print(map[string]string{"deleted": arguments[0]}, [][]string{{"DELETED"}, {arguments[0]}})
+}
+
+// trackingSet changes the tracking settings passed as flags, keeping the other setting as it is.
+func trackingSet(cli *cli, args []string) error {
+	open := cli.flags.Bool("open", false, "Enable open tracking")
+	click := cli.flags.Bool("click", false, "Enable click tracking")
+	subdomain := cli.flags.String("subdomain", "", "Tracking subdomain (e.g. links.example.com), empty for the default of sweego")
+	https := cli.flags.Bool("https", false, "Use HTTPS for tracked links")
+
+	arguments, err := cli.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	domain, err := api.GetDomain("", arguments[0])
+	if err != nil {
+		return err
+	}
+	tracking := sweego.SweegoTrackingChangeRequest{
+		OpenTrackingEnabled:  domain.TrackingOpenEnabled,
+		ClickTrackingEnabled: domain.TrackingClickEnabled,
+		TrackingSubdomain:    &domain.TrackingSubdomain,
+		HttpsEnabled:         &domain.TrackingHttpsEnabled,
+	}
+	cli.flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "open":
+			tracking.OpenTrackingEnabled = *open
+		case "click":
+			tracking.ClickTrackingEnabled = *click
+		case "subdomain":
+			tracking.TrackingSubdomain = subdomain
+		case "https":
+			tracking.HttpsEnabled = https
+		}
+	})
+
+	if err := api.UpdateTracking("", arguments[0], tracking); err != nil {
+		return err
+	}
+	return cli.print(tracking, [][]string{
+		{"UUID", "OPEN TRACKING", "CLICK TRACKING", "SUBDOMAIN", "HTTPS"},
+		{arguments[0], strconv.FormatBool(tracking.OpenTrackingEnabled), strconv.FormatBool(tracking.ClickTrackingEnabled), *tracking.TrackingSubdomain, strconv.FormatBool(*tracking.HttpsEnabled)},
+	})
+}
+
+// recordsExport prints the records in the given format. -o does not apply, as the format defines the output.
+func recordsExport(cli *cli, args []string) error {
+	format := cli.flags.String("format", sweego.RecordFormatBind, "Record format")
+
+	arguments, err := cli.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	domain, err := api.GetDomain("", arguments[0])
+	if err != nil {
+		return err
+	}
+	rendered, err := domain.RenderRecords(*format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(rendered)
+	return nil
+}
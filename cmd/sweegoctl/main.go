@@ -0,0 +1,84 @@
+// Command sweegoctl inspects and changes sweego domains from the command line, e.g. in order to debug
+// verification problems without the dashboard. It reads the same environment variables and credentials
+// file as the terraform provider.
+//
+// Usage:
+//
+//	sweegoctl domains list
+//	sweegoctl domains get <uuid>
+//	sweegoctl domains check <uuid>
+//	sweegoctl domains delete <uuid>
+//	sweegoctl tracking set <uuid> -open=true -click=false -subdomain links.example.com -https
+//	sweegoctl records export <uuid> -format bind|json
+//	sweegoctl terraform generate -dns-provider none|cloudflare|route53 -out sweego.tf
+//
+// All commands accept -o table|json, -client-id, -api-key, -base-url and -v (log requests).
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+const usage = `Usage: sweegoctl <command> [flags] [arguments]
+
+Commands:
+  domains list                     List all domains of the client
+  domains get <uuid>               Show a domain and its DNS records
+  domains check <uuid>             Let sweego verify the DNS records of a domain
+  domains delete <uuid>            Delete a domain
+  tracking set <uuid>              Change tracking (-open, -click, -subdomain, -https)
+  records export <uuid>            Print the required DNS records (-format %s)
+  terraform generate               Print the configuration importing all domains (-dns-provider %s, -out)
+
+Credentials are read from flags, the environment variables %s, %s and %s
+or the credentials file (%s, override using %s).
+`
+
+func main() {
+	err := run(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sweegoctl: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, usage,
+		strings.Join(sweego.RecordFormats, "|"),
+		strings.Join(dnsProviders, "|"),
+		sweego.EnvApiKey, sweego.EnvClientId, sweego.EnvBaseUrl,
+		sweego.CredentialsFile(), sweego.EnvCredentialsFile,
+	)
+}
+
+func run(args []string) error {
+	if len(args) < 2 {
+		printUsage()
+		return flag.ErrHelp
+	}
+
+	command := args[0] + " " + args[1]
+	handler, ok := commands[command]
+	if !ok {
+		printUsage()
+		return fmt.Errorf("unknown command %#v", command)
+	}
+
+	cli := &cli{flags: flag.NewFlagSet(command, flag.ContinueOnError)}
+	cli.flags.StringVar(&cli.output, "o", outputTable, "Output format: table or json")
+	cli.flags.StringVar(&cli.credentials.ApiKey, "api-key", "", "API key (default: "+sweego.EnvApiKey+")")
+	cli.flags.StringVar(&cli.credentials.ClientId, "client-id", "", "Client ID (default: "+sweego.EnvClientId+")")
+	cli.flags.StringVar(&cli.credentials.BaseUrl, "base-url", "", "Base URL of the API (default: "+sweego.EnvBaseUrl+")")
+	cli.flags.BoolVar(&cli.verbose, "v", false, "Log all requests and responses")
+
+	return handler(cli, args[2:])
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputTable = "table"
+	outputJson  = "json"
+)
+
+// print writes the API response as JSON or the table (the first row being the header) as aligned columns.
+func (cli *cli) print(response any, table [][]string) error {
+	if cli.output == outputJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(response)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, row := range table {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	return writer.Flush()
+}
+
+// discardLogger silences the API client unless -v is passed.
+type discardLogger struct{}
+
+func (discardLogger) Info(message string)  {}
+func (discardLogger) Error(message string) {}
+func (discardLogger) Debug(message string) {}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// DNS providers terraform generate can create the required records for.
+const (
+	dnsProviderNone       = "none"
+	dnsProviderCloudflare = "cloudflare"
+	dnsProviderRoute53    = "route53"
+)
+
+var dnsProviders = []string{dnsProviderNone, dnsProviderCloudflare, dnsProviderRoute53}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// terraformGenerate prints the configuration importing all existing domains. -o does not apply, as the
+// output is always HCL.
+func terraformGenerate(cli *cli, args []string) error {
+	dnsProvider := cli.flags.String("dns-provider", dnsProviderNone, "Also create the DNS records using "+strings.Join(dnsProviders, ", "))
+	out := cli.flags.String("out", "", "Write the configuration to the given .tf file instead of stdout")
+
+	if _, err := cli.parse(args, 0); err != nil {
+		return err
+	}
+	if !slices.Contains(dnsProviders, *dnsProvider) {
+		return fmt.Errorf("unknown DNS provider %#v, expected one of %s", *dnsProvider, strings.Join(dnsProviders, ", "))
+	}
+	api, err := cli.api()
+	if err != nil {
+		return err
+	}
+
+	configuration, err := generateTerraform(api, *dnsProvider)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		fmt.Print(configuration)
+		return nil
+	}
+	return os.WriteFile(*out, []byte(configuration), 0o644)
+}
+
+// generateTerraform renders a sweego_domain resource and an import block for every domain of the client,
+// sorted by domain name. Unless dnsProvider is none, the required records are created using the
+// records_for function and a zone ID variable per domain. Cloudflare expects the priority of MX records separately, so their
+// data (e.g. "10 mx.sweego.io") is split into priority and content.
+func generateTerraform(api sweego.SweegoDomainsApi, dnsProvider string) (string, error) {
+	list, err := api.ListDomains("")
+	if err != nil {
+		return "", err
+	}
+	domains := make([]sweego.SweegoDomainDetails, 0, len(list))
+	for _, entry := range list {
+		domain, err := api.GetDomain("", entry.Uuid)
+		if err != nil {
+			return "", fmt.Errorf("Cannot read domain %s (%s): %s", entry.Domain, entry.Uuid, err)
+		}
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Domain < domains[j].Domain
+	})
+
+	var builder strings.Builder
+	builder.WriteString("# Generated by sweegoctl terraform generate. Run `terraform plan` in order to review the import.\n")
+
+	used := map[string]bool{}
+	for _, domain := range domains {
+		name := resourceName(domain.Domain, used)
+
+		fmt.Fprintf(&builder, "\nimport {\n  to = sweego_domain.%s\n  id = %s\n}\n", name, hclString(domain.Uuid))
+		fmt.Fprintf(&builder, "\nresource \"sweego_domain\" %s {\n", hclString(name))
+		fmt.Fprintf(&builder, "  domain                 = %s\n", hclString(domain.Domain))
+		fmt.Fprintf(&builder, "  click_tracking_enabled = %t\n", domain.TrackingClickEnabled)
+		fmt.Fprintf(&builder, "  open_tracking_enabled  = %t\n", domain.TrackingOpenEnabled)
+		if domain.TrackingSubdomain != "" {
+			fmt.Fprintf(&builder, "  tracking_subdomain     = %s\n", hclString(domain.TrackingSubdomain))
+		}
+		if domain.TrackingHttpsEnabled {
+			builder.WriteString("  tracking_https_enabled = true\n")
+		}
+		builder.WriteString("}\n")
+
+		switch dnsProvider {
+		case dnsProviderCloudflare:
+			fmt.Fprintf(&builder, "\nvariable \"%s_zone_id\" {\n  description = %s\n  type        = string\n}\n",
+				name, hclString("Cloudflare zone ID of "+domain.Domain))
+			fmt.Fprintf(&builder, `
+resource "cloudflare_dns_record" "%[1]s" {
+  for_each = provider::sweego::records_for(sweego_domain.%[1]s)
+
+  zone_id  = var.%[1]s_zone_id
+  name     = each.value.fqdn
+  type     = each.value.type
+  content  = each.value.type == "MX" ? split(" ", each.value.data)[1] : each.value.data
+  priority = each.value.type == "MX" ? tonumber(split(" ", each.value.data)[0]) : null
+  ttl      = 3600
+}
+`, name)
+		case dnsProviderRoute53:
+			fmt.Fprintf(&builder, "\nvariable \"%s_zone_id\" {\n  description = %s\n  type        = string\n}\n",
+				name, hclString("Route 53 hosted zone ID of "+domain.Domain))
+			fmt.Fprintf(&builder, `
+resource "aws_route53_record" "%[1]s" {
+  for_each = provider::sweego::records_for(sweego_domain.%[1]s)
+
+  zone_id = var.%[1]s_zone_id
+  name    = each.value.fqdn
+  type    = each.value.type
+  records = [each.value.data]
+  ttl     = 3600
+}
+`, name)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// resourceName converts the domain name into a unique terraform identifier, e.g. mail.example.com becomes
+// mail_example_com.
+func resourceName(domain string, used map[string]bool) string {
+	base := strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(sweego.StripTrailingDot(domain)), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "domain_" + base
+	}
+
+	name := base
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// hclString quotes the value as HCL string literal, escaping template sequences.
+func hclString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
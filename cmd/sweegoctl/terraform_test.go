@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
+)
+
+var update = flag.Bool("update", false, "Update the golden files in testdata")
+
+// testDomains returns a mock containing a plain domain, a domain with branded tracking links and a domain
+// whose name starts with a digit.
+func testDomains(t *testing.T) *sweegomock.DomainsApi {
+	t.Helper()
+
+	api := sweegomock.NewDomainsApi()
+	for _, domain := range []string{"mail.example.com", "example.org", "1.example.net"} {
+		if _, err := api.CreateDomain("", domain); err != nil {
+			t.Fatalf("cannot create domain: %s", err)
+		}
+	}
+	subdomain, https := "links.example.org", true
+	err := api.UpdateTracking("", sweegomock.DomainUuid(2), sweego.SweegoTrackingChangeRequest{
+		OpenTrackingEnabled:  true,
+		ClickTrackingEnabled: true,
+		TrackingSubdomain:    &subdomain,
+		HttpsEnabled:         &https,
+	})
+	if err != nil {
+		t.Fatalf("cannot update tracking: %s", err)
+	}
+	return api
+}
+
+func TestGenerateTerraform(t *testing.T) {
+	for _, dnsProvider := range dnsProviders {
+		t.Run(dnsProvider, func(t *testing.T) {
+			configuration, err := generateTerraform(testDomains(t), dnsProvider)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			golden := filepath.Join("testdata", "terraform_generate_"+dnsProvider+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(configuration), 0o644); err != nil {
+					t.Fatalf("cannot update golden file: %s", err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("cannot read golden file (run go test with -update in order to create it): %s", err)
+			}
+			if configuration != string(expected) {
+				t.Errorf("configuration does not match %s (run go test with -update after reviewing the change):\n%s", golden, configuration)
+			}
+		})
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	used := map[string]bool{}
+	tests := []struct {
+		domain   string
+		expected string
+	}{
+		{"mail.example.com", "mail_example_com"},
+		{"Mail.Example.com.", "mail_example_com_2"},
+		{"1.example.com", "domain_1_example_com"},
+		{"xn--bcher-kva.example", "xn_bcher_kva_example"},
+	}
+	for _, test := range tests {
+		if actual := resourceName(test.domain, used); actual != test.expected {
+			t.Errorf("resourceName(%#v) = %#v, expected %#v", test.domain, actual, test.expected)
+		}
+	}
+}
+
+func TestHclString(t *testing.T) {
+	tests := map[string]string{
+		"example.com":   `"example.com"`,
+		`quote"`:        `"quote\""`,
+		"${var.domain}": `"$${var.domain}"`,
+		"%{if true}":    `"%%{if true}"`,
+	}
+	for value, expected := range tests {
+		if actual := hclString(value); actual != expected {
+			t.Errorf("hclString(%#v) = %#v, expected %#v", value, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+# terraform apply -invoke action.sweego_domain_check.test_domain
+action "sweego_domain_check" "test_domain" {
+  config {
+    uuid = sweego_domain.test_domain.uuid
+
+    # Optional
+    fail_on_unverified = true
+  }
+}
@@ -0,0 +1,6 @@
+# terraform apply -invoke action.sweego_domain_rotate_dkim.test_domain
+action "sweego_domain_rotate_dkim" "test_domain" {
+  config {
+    uuid = sweego_domain.test_domain.uuid
+  }
+}
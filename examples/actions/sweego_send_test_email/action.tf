@@ -0,0 +1,10 @@
+# terraform apply -invoke action.sweego_send_test_email.test_domain
+action "sweego_send_test_email" "test_domain" {
+  config {
+    from = "noreply@foo.com"
+    to   = "postmaster@foo.com"
+
+    # Optional
+    subject = "Testing the new DKIM key"
+  }
+}
@@ -0,0 +1,5 @@
+data sweego_dedicated_ips "all" {}
+
+output "warmed_up_ips" {
+  value = [for ip in data.sweego_dedicated_ips.all.ips : ip.ip if ip.warmup_status == "done"]
+}
@@ -0,0 +1,11 @@
+data sweego_suppressions "bounces" {
+  # Optional
+  reason         = "bounce"
+  domain         = "foo.com"
+  created_after  = "2026-01-01T00:00:00Z"
+  created_before = "2026-02-01T00:00:00Z"
+}
+
+output "bounced_addresses" {
+  value = data.sweego_suppressions.bounces.suppressions[*].email
+}
@@ -0,0 +1,10 @@
+resource sweego_domain "test_domain" {
+  domain = "foo.com"
+}
+
+resource "inwx_nameserver_record" "test_dkim" {
+  domain  = "foo.com"
+  name    = provider::sweego::record_fqdn(resource.sweego_domain.test_domain.dkim_record, resource.sweego_domain.test_domain.domain)
+  content = resource.sweego_domain.test_domain.dkim_record.data
+  type    = resource.sweego_domain.test_domain.dkim_record.type
+}
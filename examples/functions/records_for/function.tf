@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    sweego = {
+      source = "j6s/sweego"
+    }
+  }
+}
+
+resource sweego_domain "test_domain" {
+  domain = "foo.com"
+}
+
+# Create all required records with a single resource. The keys of records_for are unknown until the domain
+# exists, so create the domain first if it is new:
+#   terraform apply -target=sweego_domain.test_domain
+#   terraform apply
+resource "inwx_nameserver_record" "sweego" {
+  for_each = provider::sweego::records_for(resource.sweego_domain.test_domain)
+
+  domain  = "foo.com"
+  name    = each.value.fqdn
+  type    = each.value.type
+  content = each.value.data
+}
+
+# Alternatively, iterate over the records that are always provided, which works in a single apply
+locals {
+  sweego_records = provider::sweego::records_for(resource.sweego_domain.test_domain)
+}
+
+resource "inwx_nameserver_record" "sweego_single_apply" {
+  for_each = toset(["domain", "dkim", "dmarc", "tracking"])
+
+  domain  = "foo.com"
+  name    = local.sweego_records[each.key].fqdn
+  type    = local.sweego_records[each.key].type
+  content = local.sweego_records[each.key].data
+}
@@ -0,0 +1,9 @@
+resource sweego_domain "test_domain" {
+  domain = "foo.com"
+}
+
+# Write the records to a file that is included by an OctoDNS configuration
+resource "local_file" "octodns_sweego" {
+  filename = "${path.module}/octodns/foo.com.sweego.yaml"
+  content  = provider::sweego::render_records(resource.sweego_domain.test_domain, "octodns_yaml")
+}
@@ -0,0 +1,4 @@
+output "domain_record_target" {
+  # abc.sweego.io. => abc.sweego.io
+  value = provider::sweego::strip_trailing_dot(resource.sweego_domain.test_domain.domain_record.data)
+}
@@ -7,4 +7,28 @@ resource sweego_domain "test_domain" {
   domain = "foo.com"
   open_tracking_enabled = false
   click_tracking_enabled = true
-}
\ No newline at end of file
+}
+
+# Resolve the records required by sweego against the given and the authoritative nameservers
+# and report problems as warnings.
+provider "sweego" {
+  alias     = "preflight"
+  api_key   = "..."
+  client_id = "..."
+
+  dns_preflight = {
+    nameservers   = ["1.1.1.1", "8.8.8.8:53"]
+    authoritative = true
+  }
+}
+
+# Send requests through a TLS intercepting proxy
+provider "sweego" {
+  alias     = "proxy"
+  api_key   = "..."
+  client_id = "..."
+
+  request_timeout = "30s"
+  proxy_url       = "http://proxy.internal:3128"
+  ca_cert_file    = "/etc/ssl/certs/internal-ca.pem"
+}
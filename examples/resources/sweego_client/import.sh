@@ -0,0 +1 @@
+terraform import sweego_client.customer 12345
@@ -0,0 +1,9 @@
+resource sweego_client "customer" {
+  name = "Customer Inc."
+}
+
+# Manage a domain on behalf of the sub-client
+resource sweego_domain "customer_domain" {
+  client_id = sweego_client.customer.id
+  domain    = "customer.com"
+}
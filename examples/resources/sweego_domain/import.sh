@@ -1 +1,4 @@
- terraform import sweego_domain.test_domain d3b47588-c1f7-4147-afd9-893884a5c9d3
\ No newline at end of file
+terraform import sweego_domain.test_domain d3b47588-c1f7-4147-afd9-893884a5c9d3
+
+# Domains of other clients than the one configured in the provider are imported using client_id/uuid
+terraform import sweego_domain.test_domain 12345/d3b47588-c1f7-4147-afd9-893884a5c9d3
@@ -5,4 +5,11 @@ resource sweego_domain "test_domain" {
   # Optional
   open_tracking_enabled = false
   click_tracking_enabled = false
-}
\ No newline at end of file
+  tracking_subdomain = "links.foo.com"
+  tracking_https_enabled = true
+
+  dmarc_policy = {
+    policy                     = "quarantine"
+    aggregate_report_addresses = ["dmarc-reports@foo.com"]
+  }
+}
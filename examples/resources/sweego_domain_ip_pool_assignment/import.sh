@@ -0,0 +1,2 @@
+# The import ID is the UUID of the domain
+terraform import sweego_domain_ip_pool_assignment.test_domain d3b47588-c1f7-4147-afd9-893884a5c9d3
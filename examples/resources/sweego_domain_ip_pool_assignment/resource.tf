@@ -0,0 +1,13 @@
+resource sweego_domain "test_domain" {
+  domain = "foo.com"
+}
+
+resource sweego_ip_pool "transactional" {
+  name = "transactional"
+  ips  = ["192.0.2.10", "192.0.2.11"]
+}
+
+resource sweego_domain_ip_pool_assignment "test_domain" {
+  domain_uuid  = resource.sweego_domain.test_domain.uuid
+  ip_pool_uuid = resource.sweego_ip_pool.transactional.uuid
+}
@@ -0,0 +1 @@
+terraform import sweego_ip_pool.transactional 0f5c2d7e-8a41-4c1b-9a3e-5b7d6c4e2f10
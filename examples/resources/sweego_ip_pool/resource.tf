@@ -0,0 +1,4 @@
+resource sweego_ip_pool "transactional" {
+  name = "transactional"
+  ips  = ["192.0.2.10", "192.0.2.11"]
+}
@@ -0,0 +1 @@
+terraform import sweego_sender.newsletter d3b47588-c1f7-4147-afd9-893884a5c9d3/7b1f3c2e-5d0a-4a4e-9a55-2f0c1c9e8d11
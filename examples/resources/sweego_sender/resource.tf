@@ -0,0 +1,13 @@
+resource sweego_domain "test_domain" {
+  domain = "foo.com"
+}
+
+resource sweego_sender "newsletter" {
+  domain_uuid = resource.sweego_domain.test_domain.uuid
+  email       = "newsletter@foo.com"
+
+  # Optional
+  name       = "Foo Newsletter"
+  reply_to   = "support@foo.com"
+  is_default = true
+}
@@ -0,0 +1 @@
+terraform import sweego_suppression.blocked noreply@competitor.example
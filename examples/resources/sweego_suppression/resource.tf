@@ -0,0 +1,11 @@
+# Pin an address on the suppression list
+resource sweego_suppression "blocked" {
+  email  = "noreply@competitor.example"
+  reason = "manual"
+}
+
+# Make sure a seed test inbox is never suppressed
+resource sweego_suppression "seed_inbox" {
+  email      = "seed@foo.com"
+  suppressed = false
+}
@@ -0,0 +1,213 @@
+// Package dmarc builds and parses DMARC policy records as described in RFC 7489.
+package dmarc
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	PolicyNone       = "none"
+	PolicyQuarantine = "quarantine"
+	PolicyReject     = "reject"
+
+	AlignmentRelaxed = "r"
+	AlignmentStrict  = "s"
+)
+
+var Policies = []string{PolicyNone, PolicyQuarantine, PolicyReject}
+
+// Record is a DMARC policy record. Empty values are omitted when rendering the record,
+// receivers will use the defaults defined by RFC 7489 for them.
+type Record struct {
+	Policy          string
+	SubdomainPolicy string
+	// Percentage of messages the policy is applied to. nil means the default of 100.
+	Percentage     *int64
+	AggregateUris  []string
+	FailureUris    []string
+	DkimAlignment  string
+	SpfAlignment   string
+	FailureOptions string
+	// ReportInterval in seconds. nil means the default of 86400.
+	ReportInterval *int64
+}
+
+// String renders the TXT value of the record. Tags are rendered in the order recommended by RFC 7489.
+func (record Record) String() string {
+	tags := []string{"v=DMARC1", "p=" + record.Policy}
+	if record.SubdomainPolicy != "" {
+		tags = append(tags, "sp="+record.SubdomainPolicy)
+	}
+	if record.Percentage != nil {
+		tags = append(tags, "pct="+strconv.FormatInt(*record.Percentage, 10))
+	}
+	if len(record.AggregateUris) > 0 {
+		tags = append(tags, "rua="+strings.Join(record.AggregateUris, ","))
+	}
+	if len(record.FailureUris) > 0 {
+		tags = append(tags, "ruf="+strings.Join(record.FailureUris, ","))
+	}
+	if record.DkimAlignment != "" {
+		tags = append(tags, "adkim="+record.DkimAlignment)
+	}
+	if record.SpfAlignment != "" {
+		tags = append(tags, "aspf="+record.SpfAlignment)
+	}
+	if record.FailureOptions != "" {
+		tags = append(tags, "fo="+record.FailureOptions)
+	}
+	if record.ReportInterval != nil {
+		tags = append(tags, "ri="+strconv.FormatInt(*record.ReportInterval, 10))
+	}
+	return strings.Join(tags, "; ")
+}
+
+// ReportUri turns a plain E-Mail address into a mailto: URI. URIs are returned unchanged.
+func ReportUri(address string) string {
+	if strings.Contains(address, ":") {
+		return address
+	}
+	return "mailto:" + address
+}
+
+// Parse parses and validates the TXT value of a DMARC record. In contrast to receivers, which ignore
+// unknown tags, unknown tags are treated as errors in order to catch typos.
+func Parse(value string) (Record, error) {
+	record := Record{}
+
+	parts := strings.Split(strings.TrimSpace(value), ";")
+	if strings.TrimSpace(parts[len(parts)-1]) == "" {
+		parts = parts[:len(parts)-1]
+	}
+
+	seen := map[string]bool{}
+	for i, part := range parts {
+		tag, tagValue, found := strings.Cut(part, "=")
+		tag = strings.TrimSpace(tag)
+		tagValue = strings.TrimSpace(tagValue)
+		if !found || tag == "" {
+			return record, fmt.Errorf("invalid tag %#v: tags must have the form name=value", strings.TrimSpace(part))
+		}
+		if seen[tag] {
+			return record, fmt.Errorf("duplicate tag %#v", tag)
+		}
+		seen[tag] = true
+
+		if i == 0 {
+			if tag != "v" || tagValue != "DMARC1" {
+				return record, fmt.Errorf("record must start with v=DMARC1, got %#v", strings.TrimSpace(part))
+			}
+			continue
+		}
+
+		var err error
+		switch tag {
+		case "p":
+			record.Policy, err = parsePolicy(tagValue)
+		case "sp":
+			record.SubdomainPolicy, err = parsePolicy(tagValue)
+		case "pct":
+			record.Percentage, err = parseInt(tagValue, 0, 100)
+		case "rua":
+			record.AggregateUris, err = parseUris(tagValue)
+		case "ruf":
+			record.FailureUris, err = parseUris(tagValue)
+		case "adkim":
+			record.DkimAlignment, err = parseAlignment(tagValue)
+		case "aspf":
+			record.SpfAlignment, err = parseAlignment(tagValue)
+		case "fo":
+			record.FailureOptions, err = parseFailureOptions(tagValue)
+		case "ri":
+			record.ReportInterval, err = parseInt(tagValue, 0, 4294967295)
+		case "v":
+			err = fmt.Errorf("v must be the first tag")
+		default:
+			err = fmt.Errorf("unknown tag")
+		}
+		if err != nil {
+			return record, fmt.Errorf("invalid tag %#v: %s", strings.TrimSpace(part), err)
+		}
+	}
+
+	if len(parts) == 0 {
+		return record, fmt.Errorf("record must start with v=DMARC1")
+	}
+	if record.Policy == "" {
+		return record, fmt.Errorf("required tag p is missing")
+	}
+
+	return record, nil
+}
+
+func parsePolicy(value string) (string, error) {
+	for _, policy := range Policies {
+		if value == policy {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("must be one of %s", strings.Join(Policies, ", "))
+}
+
+func parseAlignment(value string) (string, error) {
+	if value != AlignmentRelaxed && value != AlignmentStrict {
+		return "", fmt.Errorf("must be %s (relaxed) or %s (strict)", AlignmentRelaxed, AlignmentStrict)
+	}
+	return value, nil
+}
+
+func parseInt(value string, min int64, max int64) (*int64, error) {
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < min || number > max {
+		return nil, fmt.Errorf("must be a number between %d and %d", min, max)
+	}
+	return &number, nil
+}
+
+func parseFailureOptions(value string) (string, error) {
+	for _, option := range strings.Split(value, ":") {
+		switch option {
+		case "0", "1", "d", "s":
+		default:
+			return "", fmt.Errorf("must be a colon separated list of 0, 1, d and s")
+		}
+	}
+	return value, nil
+}
+
+// parseUris validates a comma separated list of report URIs. Each URI may carry a size limit (e.g. !10m).
+func parseUris(value string) ([]string, error) {
+	uris := []string{}
+	for _, uri := range strings.Split(value, ",") {
+		uri = strings.TrimSpace(uri)
+		address, size, hasSize := strings.Cut(uri, "!")
+		if hasSize && !isSizeLimit(size) {
+			return nil, fmt.Errorf("%#v has an invalid size limit", uri)
+		}
+
+		parsed, err := url.Parse(address)
+		if err != nil || parsed.Scheme == "" {
+			return nil, fmt.Errorf("%#v is not a valid URI", uri)
+		}
+		if parsed.Scheme == "mailto" {
+			if _, err := mail.ParseAddress(parsed.Opaque); err != nil {
+				return nil, fmt.Errorf("%#v does not contain a valid E-Mail address: %s", uri, err)
+			}
+		}
+		uris = append(uris, uri)
+	}
+	return uris, nil
+}
+
+func isSizeLimit(size string) bool {
+	digits := strings.TrimRight(size, "kmgt")
+	if len(size)-len(digits) > 1 || digits == "" {
+		return false
+	}
+	_, err := strconv.ParseUint(digits, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,176 @@
+package dmarc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected Record
+		// err is a part of the expected error message, empty if the value is valid
+		err string
+	}{
+		{value: "v=DMARC1; p=none", expected: Record{Policy: PolicyNone}},
+		{value: " v=DMARC1;p=reject; ", expected: Record{Policy: PolicyReject}},
+		{
+			value: "v=DMARC1; p=quarantine; sp=reject; pct=50; rua=mailto:dmarc@example.com,mailto:agg@example.org!10m; ruf=mailto:forensic@example.com; adkim=s; aspf=r; fo=0:d:s; ri=3600",
+			expected: Record{
+				Policy:          PolicyQuarantine,
+				SubdomainPolicy: PolicyReject,
+				Percentage:      int64Pointer(50),
+				AggregateUris:   []string{"mailto:dmarc@example.com", "mailto:agg@example.org!10m"},
+				FailureUris:     []string{"mailto:forensic@example.com"},
+				DkimAlignment:   AlignmentStrict,
+				SpfAlignment:    AlignmentRelaxed,
+				FailureOptions:  "0:d:s",
+				ReportInterval:  int64Pointer(3600),
+			},
+		},
+		{value: "v=DMARC1; p=none; pct=0", expected: Record{Policy: PolicyNone, Percentage: int64Pointer(0)}},
+		{value: "v=DMARC1; p=none; rua=https://reports.example.com/dmarc", expected: Record{Policy: PolicyNone, AggregateUris: []string{"https://reports.example.com/dmarc"}}},
+
+		{value: "", err: "must start with v=DMARC1"},
+		{value: "p=none; v=DMARC1", err: "must start with v=DMARC1"},
+		{value: "v=DMARC2; p=none", err: "must start with v=DMARC1"},
+		{value: "v=DMARC1", err: "required tag p is missing"},
+		{value: "v=DMARC1; p=none; v=DMARC1", err: "duplicate tag"},
+		{value: "v=DMARC1; p=none; p=reject", err: "duplicate tag"},
+		{value: "v=DMARC1; p=none; adkim", err: "tags must have the form name=value"},
+		{value: "v=DMARC1; p=block", err: "must be one of none, quarantine, reject"},
+		{value: "v=DMARC1; p=none; sp=allow", err: "must be one of none, quarantine, reject"},
+		{value: "v=DMARC1; p=none; polcy=reject", err: "unknown tag"},
+		{value: "v=DMARC1; p=none; ruaa=mailto:dmarc@example.com", err: "unknown tag"},
+		{value: "v=DMARC1; p=none; pct=101", err: "between 0 and 100"},
+		{value: "v=DMARC1; p=none; pct=-1", err: "between 0 and 100"},
+		{value: "v=DMARC1; p=none; pct=half", err: "between 0 and 100"},
+		{value: "v=DMARC1; p=none; fo=2", err: "colon separated list"},
+		{value: "v=DMARC1; p=none; fo=0,1", err: "colon separated list"},
+		{value: "v=DMARC1; p=none; fo=", err: "colon separated list"},
+		{value: "v=DMARC1; p=none; ri=-1", err: "between 0 and 4294967295"},
+		{value: "v=DMARC1; p=none; ri=4294967296", err: "between 0 and 4294967295"},
+		{value: "v=DMARC1; p=none; ri=1d", err: "between 0 and 4294967295"},
+		{value: "v=DMARC1; p=none; adkim=strict", err: "must be r (relaxed) or s (strict)"},
+		{value: "v=DMARC1; p=none; rua=dmarc@example.com", err: "is not a valid URI"},
+		{value: "v=DMARC1; p=none; rua=mailto:dmarc!10x", err: "invalid size limit"},
+	}
+
+	for _, test := range tests {
+		record, err := Parse(test.value)
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("Parse(%#v) returned unexpected error: %s", test.value, err)
+			} else if !reflect.DeepEqual(record, test.expected) {
+				t.Errorf("Parse(%#v) = %#v, expected %#v", test.value, record, test.expected)
+			}
+		} else if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Parse(%#v) returned error %v, expected an error containing %#v", test.value, err, test.err)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		record   Record
+		expected string
+	}{
+		{Record{Policy: PolicyNone}, "v=DMARC1; p=none"},
+		{Record{Policy: PolicyReject, Percentage: int64Pointer(0)}, "v=DMARC1; p=reject; pct=0"},
+		{
+			Record{
+				Policy:          PolicyQuarantine,
+				SubdomainPolicy: PolicyNone,
+				Percentage:      int64Pointer(25),
+				AggregateUris:   []string{"mailto:a@example.com", "mailto:b@example.com!5m"},
+				FailureUris:     []string{"mailto:f@example.com"},
+				DkimAlignment:   AlignmentStrict,
+				SpfAlignment:    AlignmentStrict,
+				FailureOptions:  "1",
+				ReportInterval:  int64Pointer(86400),
+			},
+			"v=DMARC1; p=quarantine; sp=none; pct=25; rua=mailto:a@example.com,mailto:b@example.com!5m; ruf=mailto:f@example.com; adkim=s; aspf=s; fo=1; ri=86400",
+		},
+	}
+
+	for _, test := range tests {
+		if actual := test.record.String(); actual != test.expected {
+			t.Errorf("String() = %#v, expected %#v", actual, test.expected)
+		}
+
+		// Rendered records need to pass the validation
+		parsed, err := Parse(test.record.String())
+		if err != nil {
+			t.Errorf("Parse(%#v) returned unexpected error: %s", test.expected, err)
+		} else if !reflect.DeepEqual(parsed, test.record) {
+			t.Errorf("Parse(%#v) = %#v, expected the rendered record %#v", test.expected, parsed, test.record)
+		}
+	}
+}
+
+func TestReportUri(t *testing.T) {
+	tests := map[string]string{
+		"dmarc@example.com":                 "mailto:dmarc@example.com",
+		"mailto:dmarc@example.com":          "mailto:dmarc@example.com",
+		"https://reports.example.com/dmarc": "https://reports.example.com/dmarc",
+	}
+	for address, expected := range tests {
+		if actual := ReportUri(address); actual != expected {
+			t.Errorf("ReportUri(%#v) = %#v, expected %#v", address, actual, expected)
+		}
+	}
+}
+
+func TestParseUris(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+		valid    bool
+	}{
+		{"mailto:dmarc@example.com", []string{"mailto:dmarc@example.com"}, true},
+		{"mailto:a@example.com, mailto:b@example.com!10m", []string{"mailto:a@example.com", "mailto:b@example.com!10m"}, true},
+		{"https://reports.example.com/dmarc!1g", []string{"https://reports.example.com/dmarc!1g"}, true},
+		{"dmarc@example.com", nil, false},
+		{"mailto:not-an-address", nil, false},
+		{"mailto:dmarc@example.com,", nil, false},
+		{"mailto:dmarc@example.com!", nil, false},
+		{"mailto:dmarc@example.com!10mb", nil, false},
+	}
+
+	for _, test := range tests {
+		uris, err := parseUris(test.value)
+		if (err == nil) != test.valid {
+			t.Errorf("parseUris(%#v) returned error %v, expected valid = %t", test.value, err, test.valid)
+		}
+		if !reflect.DeepEqual(uris, test.expected) {
+			t.Errorf("parseUris(%#v) = %#v, expected %#v", test.value, uris, test.expected)
+		}
+	}
+}
+
+func TestIsSizeLimit(t *testing.T) {
+	tests := map[string]bool{
+		"10":   true,
+		"10k":  true,
+		"10m":  true,
+		"1g":   true,
+		"2t":   true,
+		"0":    true,
+		"":     false,
+		"m":    false,
+		"10mm": false,
+		"10x":  false,
+		"-10m": false,
+		"1.5m": false,
+	}
+	for size, expected := range tests {
+		if actual := isSizeLimit(size); actual != expected {
+			t.Errorf("isSizeLimit(%#v) = %t, expected %t", size, actual, expected)
+		}
+	}
+}
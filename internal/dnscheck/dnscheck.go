@@ -0,0 +1,226 @@
+// Package dnscheck resolves the DNS records required by sweego against specific nameservers,
+// in order to detect problems (e.g. split-horizon DNS, slow propagation or typos) before sweego
+// tries to verify them.
+package dnscheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// DefaultTimeout is used for every single DNS query if no timeout is configured.
+const DefaultTimeout = 5 * time.Second
+
+type Checker struct {
+	// Nameservers to query, in the form host or host:port. The port defaults to 53.
+	Nameservers []string
+	// Whether the authoritative nameservers of the domain should be queried as well.
+	Authoritative bool
+	Timeout       time.Duration
+}
+
+// Mismatch is a problem with a single record found on a single nameserver.
+type Mismatch struct {
+	Record     sweego.SweegoRequiredRecord
+	Nameserver string
+	Problem    string
+}
+
+func (mismatch Mismatch) String() string {
+	return fmt.Sprintf("%s %s (%s) on %s: %s", mismatch.Record.Type, mismatch.Record.Fqdn, mismatch.Record.Purpose, mismatch.Nameserver, mismatch.Problem)
+}
+
+// Check resolves all given records of the domain against all nameservers and returns the problems found.
+// An error is only returned if the nameservers themselves cannot be determined.
+func (checker *Checker) Check(ctx context.Context, domain string, records []sweego.SweegoRequiredRecord) ([]Mismatch, error) {
+	nameservers, err := checker.nameservers(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	mismatches := []Mismatch{}
+	for _, nameserver := range nameservers {
+		for _, record := range records {
+			if problem := checker.checkRecord(ctx, nameserver, domain, record); problem != "" {
+				mismatches = append(mismatches, Mismatch{Record: record, Nameserver: nameserver, Problem: problem})
+			}
+		}
+
+		if problem := checker.checkSpf(ctx, nameserver, domain); problem != "" {
+			mismatches = append(mismatches, Mismatch{
+				Record:     sweego.SweegoRequiredRecord{Purpose: "spf", Fqdn: sweego.StripTrailingDot(domain), Type: "TXT"},
+				Nameserver: nameserver,
+				Problem:    problem,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+func (checker *Checker) nameservers(ctx context.Context, domain string) ([]string, error) {
+	nameservers := []string{}
+	for _, nameserver := range checker.Nameservers {
+		nameservers = append(nameservers, withDefaultPort(nameserver))
+	}
+
+	if checker.Authoritative {
+		authoritative, err := net.DefaultResolver.LookupNS(ctx, sweego.StripTrailingDot(domain))
+		if err != nil {
+			return nil, fmt.Errorf("Cannot determine authoritative nameservers of %s: %s", domain, err)
+		}
+		for _, ns := range authoritative {
+			nameservers = append(nameservers, withDefaultPort(sweego.StripTrailingDot(ns.Host)))
+		}
+	}
+
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("No nameservers configured: Configure nameservers or enable checking the authoritative nameservers")
+	}
+
+	return nameservers, nil
+}
+
+func withDefaultPort(nameserver string) string {
+	if _, _, err := net.SplitHostPort(nameserver); err == nil {
+		return nameserver
+	}
+	return net.JoinHostPort(strings.Trim(nameserver, "[]"), "53")
+}
+
+// checkRecord returns a description of the problem with the record or an empty string if it is published correctly.
+func (checker *Checker) checkRecord(ctx context.Context, nameserver string, domain string, record sweego.SweegoRequiredRecord) string {
+	switch record.Type {
+	case "CNAME":
+		return checker.checkCname(ctx, nameserver, domain, record)
+	case "TXT":
+		return checker.checkTxt(ctx, nameserver, domain, record)
+	case "MX":
+		return checker.checkMx(ctx, nameserver, domain, record)
+	default:
+		return ""
+	}
+}
+
+func (checker *Checker) checkCname(ctx context.Context, nameserver string, domain string, record sweego.SweegoRequiredRecord) string {
+	targets, err := checker.lookupCname(ctx, nameserver, record.Fqdn)
+	if err != nil {
+		return fmt.Sprintf("lookup failed: %s", err)
+	}
+	if len(targets) == 0 {
+		return checker.missing(ctx, nameserver, domain, record, "CNAME", "no CNAME record found")
+	}
+
+	target := targets[0]
+	if strings.EqualFold(target, record.Data) {
+		return ""
+	}
+	if strings.EqualFold(target, record.Data+"."+sweego.StripTrailingDot(domain)) {
+		return fmt.Sprintf("CNAME target %s is missing a trailing dot in the zone, it resolves to %s", record.Data, target)
+	}
+	return fmt.Sprintf("wrong CNAME target: expected %s, got %s", record.Data, target)
+}
+
+func (checker *Checker) checkTxt(ctx context.Context, nameserver string, domain string, record sweego.SweegoRequiredRecord) string {
+	values, err := checker.lookupTxt(ctx, nameserver, record.Fqdn)
+	if err != nil {
+		return fmt.Sprintf("lookup failed: %s", err)
+	}
+
+	found := false
+	for _, value := range values {
+		if normalizeTxt(value) == normalizeTxt(record.Data) {
+			found = true
+		}
+	}
+
+	if record.Purpose == "dmarc" && countPrefixed(values, "v=DMARC1") > 1 {
+		return fmt.Sprintf("multiple DMARC records found, receivers will ignore all of them: %s", strings.Join(values, " | "))
+	}
+	if found {
+		return ""
+	}
+	if len(values) > 0 {
+		return fmt.Sprintf("TXT record has unexpected value: expected %#v, got %#v", record.Data, strings.Join(values, " | "))
+	}
+	return checker.missing(ctx, nameserver, domain, record, "TXT", "no TXT record found")
+}
+
+func (checker *Checker) checkMx(ctx context.Context, nameserver string, domain string, record sweego.SweegoRequiredRecord) string {
+	fields := strings.Fields(record.Data)
+	if len(fields) == 0 {
+		return fmt.Sprintf("MX record without exchange: %#v", record.Data)
+	}
+
+	exchanges, err := checker.lookupMx(ctx, nameserver, record.Fqdn)
+	if err != nil {
+		return fmt.Sprintf("lookup failed: %s", err)
+	}
+
+	expected := sweego.StripTrailingDot(fields[len(fields)-1])
+	for _, exchange := range exchanges {
+		if strings.EqualFold(exchange, expected) {
+			return ""
+		}
+		if strings.EqualFold(exchange, expected+"."+sweego.StripTrailingDot(domain)) {
+			return fmt.Sprintf("MX exchange %s is missing a trailing dot in the zone, it resolves to %s", expected, exchange)
+		}
+	}
+	if len(exchanges) > 0 {
+		return fmt.Sprintf("MX record for %s not found, got: %s", expected, strings.Join(exchanges, ", "))
+	}
+	return "no MX record found"
+}
+
+// checkSpf detects multiple SPF records on the domain, which makes SPF validation fail (RFC 7208 section 3.2).
+func (checker *Checker) checkSpf(ctx context.Context, nameserver string, domain string) string {
+	values, err := checker.lookupTxt(ctx, nameserver, sweego.StripTrailingDot(domain))
+	if err != nil {
+		return ""
+	}
+	if countPrefixed(values, "v=spf1") > 1 {
+		return fmt.Sprintf("duplicate SPF records found, SPF validation will fail: %s", strings.Join(values, " | "))
+	}
+	return ""
+}
+
+// missing builds the problem description for a record that was not found. It detects the common mistake of
+// a relative record name that already contains the domain, leading to the record being published at
+// name.domain.domain instead.
+func (checker *Checker) missing(ctx context.Context, nameserver string, domain string, record sweego.SweegoRequiredRecord, recordType string, problem string) string {
+	doubled := record.Fqdn + "." + sweego.StripTrailingDot(domain)
+
+	var found bool
+	switch recordType {
+	case "CNAME":
+		targets, _ := checker.lookupCname(ctx, nameserver, doubled)
+		found = len(targets) > 0
+	case "TXT":
+		values, _ := checker.lookupTxt(ctx, nameserver, doubled)
+		found = len(values) > 0
+	}
+
+	if found {
+		return fmt.Sprintf("%s, but a record exists at %s - the record name probably contains the domain twice", problem, doubled)
+	}
+	return problem
+}
+
+func normalizeTxt(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func countPrefixed(values []string, prefix string) int {
+	count := 0
+	for _, value := range values {
+		if len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+			count++
+		}
+	}
+	return count
+}
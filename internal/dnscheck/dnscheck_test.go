@@ -0,0 +1,253 @@
+package dnscheck
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// zone maps lower-case fully qualified names (with trailing dot) to the records published for them.
+type zone map[string][]dnsmessage.ResourceBody
+
+// startServer runs a DNS server on 127.0.0.1 answering from the zone until the test ends. Names that are
+// not in the zone are answered with NXDOMAIN. If silent is set, queries are never answered.
+func startServer(t *testing.T, records zone, silent bool) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	go func() {
+		buffer := make([]byte, 65535)
+		for {
+			n, addr, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+			if silent {
+				continue
+			}
+
+			var request dnsmessage.Message
+			if err := request.Unpack(buffer[:n]); err != nil || len(request.Questions) != 1 {
+				continue
+			}
+			question := request.Questions[0]
+
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: request.ID, Response: true, Authoritative: true},
+				Questions: request.Questions,
+			}
+			bodies, ok := records[strings.ToLower(question.Name.String())]
+			if !ok {
+				response.RCode = dnsmessage.RCodeNameError
+			}
+			for _, body := range bodies {
+				if bodyType(body) != question.Type {
+					continue
+				}
+				response.Answers = append(response.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: question.Name, Type: bodyType(body), Class: dnsmessage.ClassINET, TTL: 300},
+					Body:   body,
+				})
+			}
+
+			packed, err := response.Pack()
+			if err != nil {
+				t.Errorf("cannot pack response: %s", err)
+				return
+			}
+			_, _ = conn.WriteTo(packed, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func bodyType(body dnsmessage.ResourceBody) dnsmessage.Type {
+	switch body.(type) {
+	case *dnsmessage.CNAMEResource:
+		return dnsmessage.TypeCNAME
+	case *dnsmessage.TXTResource:
+		return dnsmessage.TypeTXT
+	case *dnsmessage.MXResource:
+		return dnsmessage.TypeMX
+	default:
+		return 0
+	}
+}
+
+func cname(target string) dnsmessage.ResourceBody {
+	return &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName(target)}
+}
+
+func txt(values ...string) dnsmessage.ResourceBody {
+	return &dnsmessage.TXTResource{TXT: values}
+}
+
+func mx(exchange string) dnsmessage.ResourceBody {
+	return &dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName(exchange)}
+}
+
+var (
+	cnameRecord = sweego.SweegoRequiredRecord{Purpose: "domain", Name: "swg", Fqdn: "swg.example.com", Type: "CNAME", Data: "abc.domains.sweego.io"}
+	dmarcRecord = sweego.SweegoRequiredRecord{Purpose: "dmarc", Name: "_dmarc", Fqdn: "_dmarc.example.com", Type: "TXT", Data: "v=DMARC1; p=none"}
+	mxRecord    = sweego.SweegoRequiredRecord{Purpose: "inbound_0", Fqdn: "example.com", Type: "MX", Data: "10 mx.sweego.io"}
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     zone
+		records  []sweego.SweegoRequiredRecord
+		problems []string
+	}{
+		{
+			name: "match",
+			zone: zone{
+				"swg.example.com.":    {cname("abc.domains.sweego.io.")},
+				"_dmarc.example.com.": {txt("v=DMARC1;", " p=none")},
+				"example.com.":        {mx("mx.sweego.io."), txt("v=spf1 include:sweego.io ~all")},
+			},
+			records:  []sweego.SweegoRequiredRecord{cnameRecord, dmarcRecord, mxRecord},
+			problems: nil,
+		},
+		{
+			name: "wrong CNAME target",
+			zone: zone{
+				"swg.example.com.": {cname("other.sweego.io.")},
+			},
+			records:  []sweego.SweegoRequiredRecord{cnameRecord},
+			problems: []string{"wrong CNAME target: expected abc.domains.sweego.io, got other.sweego.io"},
+		},
+		{
+			name: "unexpected TXT value",
+			zone: zone{
+				"_dmarc.example.com.": {txt("v=DMARC1; p=reject")},
+			},
+			records:  []sweego.SweegoRequiredRecord{dmarcRecord},
+			problems: []string{"TXT record has unexpected value"},
+		},
+		{
+			name:     "NXDOMAIN",
+			zone:     zone{},
+			records:  []sweego.SweegoRequiredRecord{cnameRecord, dmarcRecord, mxRecord},
+			problems: []string{"no CNAME record found", "no TXT record found", "no MX record found"},
+		},
+		{
+			name: "CNAME target without trailing dot",
+			zone: zone{
+				"swg.example.com.": {cname("abc.domains.sweego.io.example.com.")},
+			},
+			records:  []sweego.SweegoRequiredRecord{cnameRecord},
+			problems: []string{"CNAME target abc.domains.sweego.io is missing a trailing dot"},
+		},
+		{
+			name: "MX exchange without trailing dot",
+			zone: zone{
+				"example.com.": {mx("mx.sweego.io.example.com.")},
+			},
+			records:  []sweego.SweegoRequiredRecord{mxRecord},
+			problems: []string{"MX exchange mx.sweego.io is missing a trailing dot"},
+		},
+		{
+			name: "MX record without exchange",
+			zone: zone{
+				"example.com.": {mx("mx.sweego.io.")},
+			},
+			records:  []sweego.SweegoRequiredRecord{{Purpose: "inbound_0", Fqdn: "example.com", Type: "MX", Data: " \t"}},
+			problems: []string{"MX record without exchange"},
+		},
+		{
+			name: "record name containing the domain",
+			zone: zone{
+				"swg.example.com.example.com.": {cname("abc.domains.sweego.io.")},
+			},
+			records:  []sweego.SweegoRequiredRecord{cnameRecord},
+			problems: []string{"a record exists at swg.example.com.example.com"},
+		},
+		{
+			name: "duplicate SPF records",
+			zone: zone{
+				"example.com.": {txt("v=spf1 include:sweego.io ~all"), txt("v=spf1 mx ~all")},
+			},
+			records:  nil,
+			problems: []string{"duplicate SPF records found"},
+		},
+		{
+			name: "duplicate DMARC records",
+			zone: zone{
+				"_dmarc.example.com.": {txt("v=DMARC1; p=none"), txt("v=DMARC1; p=reject")},
+			},
+			records:  []sweego.SweegoRequiredRecord{dmarcRecord},
+			problems: []string{"multiple DMARC records found"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := &Checker{Nameservers: []string{startServer(t, test.zone, false)}, Timeout: time.Second}
+
+			mismatches, err := checker.Check(context.Background(), "example.com.", test.records)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assertProblems(t, mismatches, test.problems)
+		})
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	checker := &Checker{Nameservers: []string{startServer(t, zone{}, true)}, Timeout: 50 * time.Millisecond}
+
+	mismatches, err := checker.Check(context.Background(), "example.com", []sweego.SweegoRequiredRecord{cnameRecord})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assertProblems(t, mismatches, []string{"lookup failed"})
+}
+
+func TestCheckWithoutNameservers(t *testing.T) {
+	_, err := (&Checker{}).Check(context.Background(), "example.com", []sweego.SweegoRequiredRecord{cnameRecord})
+	if err == nil || !strings.Contains(err.Error(), "No nameservers configured") {
+		t.Fatalf("expected missing nameservers error, got %v", err)
+	}
+}
+
+func TestWithDefaultPort(t *testing.T) {
+	tests := map[string]string{
+		"1.1.1.1":                "1.1.1.1:53",
+		"1.1.1.1:5353":           "1.1.1.1:5353",
+		"2606:4700:4700::1111":   "[2606:4700:4700::1111]:53",
+		"[2606:4700:4700::1111]": "[2606:4700:4700::1111]:53",
+		"ns.example.com":         "ns.example.com:53",
+	}
+	for nameserver, expected := range tests {
+		if actual := withDefaultPort(nameserver); actual != expected {
+			t.Errorf("withDefaultPort(%#v) = %#v, expected %#v", nameserver, actual, expected)
+		}
+	}
+}
+
+// assertProblems expects a mismatch containing each of the problems (in order) and no other mismatches.
+func assertProblems(t *testing.T, mismatches []Mismatch, problems []string) {
+	t.Helper()
+
+	if len(mismatches) != len(problems) {
+		t.Fatalf("expected %d problem(s) %#v, got %d: %v", len(problems), problems, len(mismatches), mismatches)
+	}
+	for i, problem := range problems {
+		if !strings.Contains(mismatches[i].Problem, problem) {
+			t.Errorf("expected problem %d to contain %#v, got %#v", i, problem, mismatches[i].Problem)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package dnscheck
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// query sends a single DNS question to the nameserver. Answers for the queried name are returned as they
+// are - CNAMEs are not followed, so the records published in the zone can be inspected directly.
+func (checker *Checker) query(ctx context.Context, nameserver string, name string, questionType dnsmessage.Type) ([]dnsmessage.Resource, error) {
+	timeout := checker.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	queryName, err := dnsmessage.NewName(strings.TrimSuffix(name, ".") + ".")
+	if err != nil {
+		return nil, fmt.Errorf("invalid name %#v: %s", name, err)
+	}
+
+	request := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: uint16(rand.UintN(1 << 16)), RecursionDesired: true},
+		Questions: []dnsmessage.Question{
+			{Name: queryName, Type: questionType, Class: dnsmessage.ClassINET},
+		},
+	}
+	packed, err := request.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := exchange(ctx, "udp", nameserver, packed)
+	if err == nil && response.Truncated {
+		response, err = exchange(ctx, "tcp", nameserver, packed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if response.ID != request.ID {
+		return nil, fmt.Errorf("response ID mismatch from %s", nameserver)
+	}
+
+	switch response.RCode {
+	case dnsmessage.RCodeSuccess, dnsmessage.RCodeNameError:
+		answers := []dnsmessage.Resource{}
+		for _, answer := range response.Answers {
+			if strings.EqualFold(answer.Header.Name.String(), queryName.String()) {
+				answers = append(answers, answer)
+			}
+		}
+		return answers, nil
+	default:
+		return nil, fmt.Errorf("%s responded with %s", nameserver, response.RCode)
+	}
+}
+
+func exchange(ctx context.Context, network string, nameserver string, packed []byte) (*dnsmessage.Message, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	var buffer []byte
+	if network == "tcp" {
+		// DNS over TCP prefixes messages with their length (RFC 1035 section 4.2.2)
+		_, err = conn.Write(binary.BigEndian.AppendUint16(nil, uint16(len(packed))))
+		if err == nil {
+			_, err = conn.Write(packed)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		length := make([]byte, 2)
+		if _, err = io.ReadFull(conn, length); err != nil {
+			return nil, err
+		}
+		buffer = make([]byte, binary.BigEndian.Uint16(length))
+		if _, err = io.ReadFull(conn, buffer); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err = conn.Write(packed); err != nil {
+			return nil, err
+		}
+		buffer = make([]byte, 65535)
+		n, err := conn.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		buffer = buffer[:n]
+	}
+
+	var response dnsmessage.Message
+	if err := response.Unpack(buffer); err != nil {
+		return nil, fmt.Errorf("cannot parse response from %s: %s", nameserver, err)
+	}
+	return &response, nil
+}
+
+func (checker *Checker) lookupCname(ctx context.Context, nameserver string, name string) ([]string, error) {
+	answers, err := checker.query(ctx, nameserver, name, dnsmessage.TypeCNAME)
+	targets := []string{}
+	for _, answer := range answers {
+		if cname, ok := answer.Body.(*dnsmessage.CNAMEResource); ok {
+			targets = append(targets, strings.TrimSuffix(cname.CNAME.String(), "."))
+		}
+	}
+	return targets, err
+}
+
+func (checker *Checker) lookupTxt(ctx context.Context, nameserver string, name string) ([]string, error) {
+	answers, err := checker.query(ctx, nameserver, name, dnsmessage.TypeTXT)
+	values := []string{}
+	for _, answer := range answers {
+		if txt, ok := answer.Body.(*dnsmessage.TXTResource); ok {
+			values = append(values, strings.Join(txt.TXT, ""))
+		}
+	}
+	return values, err
+}
+
+func (checker *Checker) lookupMx(ctx context.Context, nameserver string, name string) ([]string, error) {
+	answers, err := checker.query(ctx, nameserver, name, dnsmessage.TypeMX)
+	exchanges := []string{}
+	for _, answer := range answers {
+		if mx, ok := answer.Body.(*dnsmessage.MXResource); ok {
+			exchanges = append(exchanges, strings.TrimSuffix(mx.MX.String(), "."))
+		}
+	}
+	return exchanges, err
+}
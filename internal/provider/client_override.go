@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+const clientIdOverrideDescription = "ID of the sweego client the object belongs to. Defaults to the `client_id` configured in the provider - can be used to manage objects of multiple (sub-)clients using a single provider configuration."
+
+// clientIdOverrideResourceAttribute is the optional `client_id` attribute of resources
+// that overrides the client configured in the provider.
+var clientIdOverrideResourceAttribute = schema.StringAttribute{
+	MarkdownDescription: clientIdOverrideDescription,
+	Optional:            true,
+	PlanModifiers: []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	},
+}
+
+// clientIdOverrideDataSourceAttribute is the optional `client_id` attribute of data sources
+// that overrides the client configured in the provider.
+var clientIdOverrideDataSourceAttribute = datasourceschema.StringAttribute{
+	MarkdownDescription: clientIdOverrideDescription,
+	Optional:            true,
+}
+
+// clientIdOverrideActionAttribute is the optional `client_id` attribute of actions
+// that overrides the client configured in the provider.
+var clientIdOverrideActionAttribute = actionschema.StringAttribute{
+	MarkdownDescription: clientIdOverrideDescription,
+	Optional:            true,
+}
+
+// parseImportId splits an import ID of the form `[client_id/]id[/id...]` into the client ID - which is
+// empty if the ID does not start with one - and the given number of IDs identifying the object.
+func parseImportId(importId string, format string, parts int) (string, []string, error) {
+	ids := strings.Split(importId, "/")
+	for _, id := range ids {
+		if id == "" {
+			ids = nil
+			break
+		}
+	}
+
+	switch len(ids) {
+	case parts:
+		return "", ids, nil
+	case parts + 1:
+		return ids[0], ids[1:], nil
+	default:
+		return "", nil, fmt.Errorf("Expected an import ID in the format %s or client_id/%s, got: %#v", format, format, importId)
+	}
+}
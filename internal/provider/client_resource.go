@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ resource.Resource = &SweegoClientResource{}
+var _ resource.ResourceWithImportState = &SweegoClientResource{}
+
+func NewSweegoClientResource() resource.Resource {
+	return &SweegoClientResource{}
+}
+
+// SweegoClientResource defines the resource implementation.
+type SweegoClientResource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoClientResourceModel describes the resource data model.
+type SweegoClientResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ParentClientId types.String `tfsdk:"parent_client_id"`
+	Name           types.String `tfsdk:"name"`
+	CreationDate   types.String `tfsdk:"creation_date"`
+}
+
+func (r *SweegoClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+func (r *SweegoClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sweego sub-client of the client configured in the provider. Useful for multi-tenant setups with one client per customer: The `id` of the sub-client can be passed as `client_id` to other resources in order to manage them on behalf of the sub-client.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the client",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the sub-client in sweego's system. Pass it as `client_id` to other resources in order to manage them on behalf of the sub-client.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_client_id": schema.StringAttribute{
+				Description: "ID of the client this client was created under",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "Date the client was created",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SweegoClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = providerData.Api
+}
+
+func (r *SweegoClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweegoClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := withLogger(ctx, r.api).CreateClient("", sweego.SweegoClientChangeRequest{
+		Name: data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating client", err.Error())
+		return
+	}
+
+	data = fillClientStateFromResponse(client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweegoClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := withLogger(ctx, r.api).GetClient("", data.Id.ValueString())
+	if sweego.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading client", fmt.Sprintf("Error reading client: %s", err.Error()))
+		return
+	}
+
+	data = fillClientStateFromResponse(client, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweegoClientResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := withLogger(ctx, r.api).UpdateClient("", data.Id.ValueString(), sweego.SweegoClientChangeRequest{
+		Name: data.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating client", err.Error())
+		return
+	}
+
+	data = fillClientStateFromResponse(client, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweegoClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).DeleteClient("", data.Id.ValueString())
+	if err != nil && !sweego.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting client", fmt.Sprintf("Error deleting client: %s", err.Error()))
+	}
+}
+
+func (r *SweegoClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func fillClientStateFromResponse(response sweego.SweegoClient, state SweegoClientResourceModel) SweegoClientResourceModel {
+	if response.Id != 0 {
+		state.Id = types.StringValue(strconv.FormatInt(response.Id, 10))
+	}
+	if response.ParentId != 0 {
+		state.ParentClientId = types.StringValue(strconv.FormatInt(response.ParentId, 10))
+	} else if state.ParentClientId.IsUnknown() {
+		state.ParentClientId = types.StringNull()
+	}
+	state.Name = types.StringValue(response.Name)
+	state.CreationDate = types.StringValue(response.CreationDate)
+
+	return state
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+func TestFillClientStateFromResponse(t *testing.T) {
+	state := fillClientStateFromResponse(sweego.SweegoClient{Id: 12345, ParentId: 42, Name: "Customer Inc.", CreationDate: "2026-10-19"}, SweegoClientResourceModel{
+		Id:             types.StringUnknown(),
+		ParentClientId: types.StringUnknown(),
+	})
+	if state.Id.ValueString() != "12345" || state.ParentClientId.ValueString() != "42" || state.Name.ValueString() != "Customer Inc." {
+		t.Errorf("unexpected state %#v", state)
+	}
+
+	state = fillClientStateFromResponse(sweego.SweegoClient{Name: "Customer Inc."}, SweegoClientResourceModel{
+		Id:             types.StringValue("12345"),
+		ParentClientId: types.StringUnknown(),
+	})
+	if state.Id.ValueString() != "12345" || !state.ParentClientId.IsNull() {
+		t.Errorf("unexpected state %#v", state)
+	}
+}
+
+func TestClientImportState(t *testing.T) {
+	ctx := context.Background()
+	r := &SweegoClientResource{}
+
+	schemaResponse := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	response := resource.ImportStateResponse{State: tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "12345"}, &response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics %v", response.Diagnostics)
+	}
+
+	var state SweegoClientResourceModel
+	response.State.Get(ctx, &state)
+	if state.Id.ValueString() != "12345" {
+		t.Errorf("expected id 12345, got %s", state.Id)
+	}
+}
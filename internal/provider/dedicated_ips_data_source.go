@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ datasource.DataSource = &SweegoDedicatedIpsDataSource{}
+
+func NewSweegoDedicatedIpsDataSource() datasource.DataSource {
+	return &SweegoDedicatedIpsDataSource{}
+}
+
+// SweegoDedicatedIpsDataSource defines the data source implementation.
+type SweegoDedicatedIpsDataSource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoDedicatedIpsDataSourceModel describes the data source data model.
+type SweegoDedicatedIpsDataSourceModel struct {
+	ClientId types.String                      `tfsdk:"client_id"`
+	Ips      []SweegoDedicatedIpDataSourceItem `tfsdk:"ips"`
+}
+
+type SweegoDedicatedIpDataSourceItem struct {
+	Ip             types.String `tfsdk:"ip"`
+	ReverseDns     types.String `tfsdk:"reverse_dns"`
+	IpPoolUuid     types.String `tfsdk:"ip_pool_uuid"`
+	WarmupStatus   types.String `tfsdk:"warmup_status"`
+	WarmupProgress types.Int64  `tfsdk:"warmup_progress"`
+}
+
+func (d *SweegoDedicatedIpsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedicated_ips"
+}
+
+func (d *SweegoDedicatedIpsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the dedicated IPs of the client including their warm-up status.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideDataSourceAttribute,
+			"ips": schema.ListNestedAttribute{
+				Description: "Dedicated IPs of the client",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							Description: "IP address",
+							Computed:    true,
+						},
+						"reverse_dns": schema.StringAttribute{
+							Description: "Reverse DNS (PTR) name of the IP",
+							Computed:    true,
+						},
+						"ip_pool_uuid": schema.StringAttribute{
+							Description: "UUID of the IP pool the IP is part of. Empty, if it is not part of a pool.",
+							Computed:    true,
+						},
+						"warmup_status": schema.StringAttribute{
+							Description: "Warm-up status of the IP (e.g. pending, in_progress, done)",
+							Computed:    true,
+						},
+						"warmup_progress": schema.Int64Attribute{
+							Description: "Warm-up progress of the IP in percent",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SweegoDedicatedIpsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = providerData.Api
+}
+
+func (d *SweegoDedicatedIpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SweegoDedicatedIpsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, err := withLogger(ctx, d.api).ListDedicatedIps(data.ClientId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing dedicated IPs", fmt.Sprintf("Error listing dedicated IPs: %s", err.Error()))
+		return
+	}
+
+	data.Ips = make([]SweegoDedicatedIpDataSourceItem, len(ips))
+	for i, ip := range ips {
+		data.Ips[i] = SweegoDedicatedIpDataSourceItem{
+			Ip:             types.StringValue(ip.Ip),
+			ReverseDns:     types.StringValue(ip.ReverseDns),
+			IpPoolUuid:     types.StringValue(ip.IpPoolUuid),
+			WarmupStatus:   types.StringValue(ip.WarmupStatus),
+			WarmupProgress: types.Int64Value(ip.WarmupProgress),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
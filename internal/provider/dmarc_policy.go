@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j6s/terraform-provider-sweego-provider/internal/dmarc"
+)
+
+var dmarcAlignments = map[string]string{
+	"relaxed": dmarc.AlignmentRelaxed,
+	"strict":  dmarc.AlignmentStrict,
+}
+
+var dmarcPolicyAttribute = schema.SingleNestedAttribute{
+	Description: "DMARC policy of the domain. If set, `dmarc_record` will contain a DMARC record built from this policy instead of the record suggested by sweego. The sweego API does not allow changing the suggested record, so the record built from the policy must be published in DNS using `dmarc_record` or the records functions.",
+	Optional:    true,
+	Attributes: map[string]schema.Attribute{
+		"policy": schema.StringAttribute{
+			Description: fmt.Sprintf("Policy for E-Mails failing DMARC (p). One of %s", strings.Join(dmarc.Policies, ", ")),
+			Required:    true,
+		},
+		"subdomain_policy": schema.StringAttribute{
+			Description: fmt.Sprintf("Policy for E-Mails of subdomains failing DMARC (sp). One of %s. Defaults to the policy of the domain.", strings.Join(dmarc.Policies, ", ")),
+			Optional:    true,
+		},
+		"percentage": schema.Int64Attribute{
+			Description: "Percentage of failing E-Mails the policy is applied to (pct). Defaults to 100.",
+			Optional:    true,
+		},
+		"aggregate_report_addresses": schema.ListAttribute{
+			Description: "E-Mail addresses or URIs aggregate reports are sent to (rua). E-Mail addresses are prefixed with mailto: automatically.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"failure_report_addresses": schema.ListAttribute{
+			Description: "E-Mail addresses or URIs failure reports are sent to (ruf). E-Mail addresses are prefixed with mailto: automatically.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"dkim_alignment": schema.StringAttribute{
+			Description: "DKIM identifier alignment mode (adkim). Either relaxed or strict. Defaults to relaxed.",
+			Optional:    true,
+		},
+		"spf_alignment": schema.StringAttribute{
+			Description: "SPF identifier alignment mode (aspf). Either relaxed or strict. Defaults to relaxed.",
+			Optional:    true,
+		},
+		"failure_options": schema.StringAttribute{
+			Description: "Failure reporting options (fo) as colon separated list of 0, 1, d and s. Defaults to 0.",
+			Optional:    true,
+		},
+	},
+}
+
+// dmarcPolicyAttributeTypes returns the attribute types of objects described by dmarcPolicyAttribute.
+func dmarcPolicyAttributeTypes() map[string]attr.Type {
+	return dmarcPolicyAttribute.GetType().(types.ObjectType).AttrTypes
+}
+
+type dmarcPolicyModel struct {
+	Policy                   types.String `tfsdk:"policy"`
+	SubdomainPolicy          types.String `tfsdk:"subdomain_policy"`
+	Percentage               types.Int64  `tfsdk:"percentage"`
+	AggregateReportAddresses types.List   `tfsdk:"aggregate_report_addresses"`
+	FailureReportAddresses   types.List   `tfsdk:"failure_report_addresses"`
+	DkimAlignment            types.String `tfsdk:"dkim_alignment"`
+	SpfAlignment             types.String `tfsdk:"spf_alignment"`
+	FailureOptions           types.String `tfsdk:"failure_options"`
+}
+
+// dmarcRecordFromObject builds the DMARC record described by a dmarc_policy object and validates it by
+// parsing the rendered record. ok is false, if the object is null or not fully known yet.
+func dmarcRecordFromObject(ctx context.Context, object types.Object, diagnostics *diag.Diagnostics) (record dmarc.Record, ok bool) {
+	if object.IsNull() || object.IsUnknown() {
+		return record, false
+	}
+
+	var model dmarcPolicyModel
+	diagnostics.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diagnostics.HasError() {
+		return record, false
+	}
+
+	values := []attr.Value{model.Policy, model.SubdomainPolicy, model.Percentage, model.DkimAlignment, model.SpfAlignment, model.FailureOptions}
+	values = append(values, model.AggregateReportAddresses, model.FailureReportAddresses)
+	values = append(values, model.AggregateReportAddresses.Elements()...)
+	values = append(values, model.FailureReportAddresses.Elements()...)
+	for _, value := range values {
+		if value.IsUnknown() {
+			return record, false
+		}
+	}
+
+	attributePath := path.Root("dmarc_policy")
+	record = dmarc.Record{
+		Policy:          model.Policy.ValueString(),
+		SubdomainPolicy: model.SubdomainPolicy.ValueString(),
+		Percentage:      model.Percentage.ValueInt64Pointer(),
+		FailureOptions:  model.FailureOptions.ValueString(),
+		AggregateUris:   dmarcReportUris(ctx, model.AggregateReportAddresses, diagnostics),
+		FailureUris:     dmarcReportUris(ctx, model.FailureReportAddresses, diagnostics),
+		DkimAlignment:   dmarcAlignment(attributePath.AtName("dkim_alignment"), model.DkimAlignment, diagnostics),
+		SpfAlignment:    dmarcAlignment(attributePath.AtName("spf_alignment"), model.SpfAlignment, diagnostics),
+	}
+	if diagnostics.HasError() {
+		return record, false
+	}
+
+	if _, err := dmarc.Parse(record.String()); err != nil {
+		diagnostics.AddAttributeError(attributePath, "Invalid DMARC policy", fmt.Sprintf("The DMARC record %#v is invalid: %s", record.String(), err.Error()))
+		return record, false
+	}
+
+	return record, true
+}
+
+func dmarcReportUris(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []string {
+	addresses := []string{}
+	diagnostics.Append(list.ElementsAs(ctx, &addresses, false)...)
+
+	uris := make([]string, len(addresses))
+	for i, address := range addresses {
+		uris[i] = dmarc.ReportUri(address)
+	}
+	return uris
+}
+
+func dmarcAlignment(attributePath path.Path, value types.String, diagnostics *diag.Diagnostics) string {
+	if value.IsNull() {
+		return ""
+	}
+
+	alignment, ok := dmarcAlignments[value.ValueString()]
+	if !ok {
+		diagnostics.AddAttributeError(attributePath, "Invalid alignment mode", fmt.Sprintf("%#v is not a valid alignment mode: Use relaxed or strict", value.ValueString()))
+	}
+	return alignment
+}
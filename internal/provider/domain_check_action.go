@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ action.Action = &SweegoDomainCheckAction{}
+var _ action.ActionWithConfigure = &SweegoDomainCheckAction{}
+
+func NewSweegoDomainCheckAction() action.Action {
+	return &SweegoDomainCheckAction{}
+}
+
+// SweegoDomainCheckAction defines the action implementation.
+type SweegoDomainCheckAction struct {
+	api sweego.SweegoDomainsApi
+}
+
+// SweegoDomainCheckActionModel describes the action data model.
+type SweegoDomainCheckActionModel struct {
+	ClientId         types.String `tfsdk:"client_id"`
+	Uuid             types.String `tfsdk:"uuid"`
+	FailOnUnverified types.Bool   `tfsdk:"fail_on_unverified"`
+}
+
+func (a *SweegoDomainCheckAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_check"
+}
+
+func (a *SweegoDomainCheckAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes sweego re-check the DNS records of a domain and prints the result of every record.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideActionAttribute,
+			"uuid": schema.StringAttribute{
+				Description: "UUID of the sweego domain (e.g. sweego_domain.my_domain.uuid)",
+				Required:    true,
+			},
+			"fail_on_unverified": schema.BoolAttribute{
+				Description: "Whether unverified records should fail the action instead of only being reported (defaults to false)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *SweegoDomainCheckAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.api = providerData.Api
+}
+
+func (a *SweegoDomainCheckAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SweegoDomainCheckActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	check, err := withLogger(ctx, a.api).Check(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking domain status", fmt.Sprintf("Error checking domain status: %s", err.Error()))
+		return
+	}
+
+	unverified := []string{}
+	for _, checkResult := range check.Records() {
+		if checkResult.Verified {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%s: verified", checkResult.Record)})
+		} else {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("%s: not verified (%s)", checkResult.Record, checkResult.ErrorString)})
+			unverified = append(unverified, checkResult.Record)
+		}
+	}
+
+	if len(unverified) > 0 {
+		summary := fmt.Sprintf("The following records of domain %s are not verified by sweego: %s", data.Uuid.ValueString(), strings.Join(unverified, ", "))
+		if data.FailOnUnverified.ValueBool() {
+			resp.Diagnostics.AddError("DNS Records not verified", summary)
+		} else {
+			resp.Diagnostics.AddWarning("DNS Records not verified", summary)
+		}
+	}
+}
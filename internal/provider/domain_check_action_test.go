@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
+)
+
+// actionConfig converts the given attribute values into the configuration of the action. Attributes that
+// are not given are null.
+func actionConfig(t *testing.T, a action.Action, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var schema action.SchemaResponse
+	a.Schema(context.Background(), action.SchemaRequest{}, &schema)
+	if schema.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", schema.Diagnostics)
+	}
+
+	objectType := schema.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+	return tfsdk.Config{Schema: schema.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+// invokeAction validates the configuration (if supported by the action) and invokes the action the way terraform
+// does, returning the progress messages and diagnostics.
+func invokeAction(t *testing.T, a action.Action, values map[string]tftypes.Value) ([]string, diag.Diagnostics) {
+	t.Helper()
+
+	config := actionConfig(t, a, values)
+	if validator, ok := a.(action.ActionWithValidateConfig); ok {
+		validateResp := &action.ValidateConfigResponse{}
+		validator.ValidateConfig(context.Background(), action.ValidateConfigRequest{Config: config}, validateResp)
+		if validateResp.Diagnostics.HasError() {
+			return nil, validateResp.Diagnostics
+		}
+	}
+
+	messages := []string{}
+	resp := &action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.Invoke(context.Background(), action.InvokeRequest{Config: config}, resp)
+	return messages, resp.Diagnostics
+}
+
+// diagnosticSummaries returns the diagnostics as "Severity: Summary".
+func diagnosticSummaries(diagnostics diag.Diagnostics) []string {
+	summaries := []string{}
+	for _, diagnostic := range diagnostics {
+		summaries = append(summaries, diagnostic.Severity().String()+": "+diagnostic.Summary())
+	}
+	return summaries
+}
+
+func TestDomainCheckAction(t *testing.T) {
+	uuid := sweegomock.DomainUuid(1)
+	unverified := sweego.SweegoDomainCheckResult{
+		DomainRecord:   sweego.SweegoDomainCheckSingleResult{Verified: true},
+		DkimRecord:     sweego.SweegoDomainCheckSingleResult{ErrorString: "CNAME not found"},
+		DmarcRecord:    sweego.SweegoDomainCheckSingleResult{Verified: true},
+		TrackingRecord: sweego.SweegoDomainCheckSingleResult{Verified: true},
+	}
+
+	tests := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		result      *sweego.SweegoDomainCheckResult
+		messages    []string
+		diagnostics []string
+	}{
+		{
+			name:     "verified",
+			values:   map[string]tftypes.Value{"uuid": tftypes.NewValue(tftypes.String, uuid)},
+			messages: []string{"DKIM: verified", "DMARC: verified", "Domain (SPF): verified", "Tracking: verified", "Inbound[0]: verified"},
+		},
+		{
+			name:        "unverified",
+			values:      map[string]tftypes.Value{"uuid": tftypes.NewValue(tftypes.String, uuid)},
+			result:      &unverified,
+			messages:    []string{"DKIM: not verified (CNAME not found)", "DMARC: verified", "Domain (SPF): verified", "Tracking: verified"},
+			diagnostics: []string{"Warning: DNS Records not verified"},
+		},
+		{
+			name: "unverified fails",
+			values: map[string]tftypes.Value{
+				"uuid":               tftypes.NewValue(tftypes.String, uuid),
+				"fail_on_unverified": tftypes.NewValue(tftypes.Bool, true),
+			},
+			result:      &unverified,
+			messages:    []string{"DKIM: not verified (CNAME not found)", "DMARC: verified", "Domain (SPF): verified", "Tracking: verified"},
+			diagnostics: []string{"Error: DNS Records not verified"},
+		},
+		{
+			name:        "unknown domain",
+			values:      map[string]tftypes.Value{"uuid": tftypes.NewValue(tftypes.String, "unknown")},
+			messages:    []string{},
+			diagnostics: []string{"Error: Error checking domain status"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := sweegomock.NewDomainsApi()
+			if _, err := api.CreateDomain("", "example.com"); err != nil {
+				t.Fatal(err)
+			}
+			if test.result != nil {
+				api.CheckResults[uuid] = *test.result
+			}
+
+			messages, diagnostics := invokeAction(t, &SweegoDomainCheckAction{api: api}, test.values)
+			if !reflect.DeepEqual(messages, test.messages) {
+				t.Errorf("expected messages %#v, got %#v", test.messages, messages)
+			}
+			if summaries := diagnosticSummaries(diagnostics); !reflect.DeepEqual(summaries, append([]string{}, test.diagnostics...)) {
+				t.Errorf("expected diagnostics %v, got %v", test.diagnostics, diagnostics)
+			}
+		})
+	}
+}
+
+func TestDomainCheckActionClientId(t *testing.T) {
+	api := sweegomock.NewDomainsApi()
+	if _, err := api.CreateDomain("", "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	api.ResetCalls()
+
+	_, diagnostics := invokeAction(t, &SweegoDomainCheckAction{api: api}, map[string]tftypes.Value{
+		"client_id": tftypes.NewValue(tftypes.String, "other"),
+		"uuid":      tftypes.NewValue(tftypes.String, sweegomock.DomainUuid(1)),
+	})
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+	if calls := api.ResetCalls(); len(calls) != 1 || calls[0].ClientId != "other" {
+		t.Errorf("expected a single check of client other, got %#v", calls)
+	}
+}
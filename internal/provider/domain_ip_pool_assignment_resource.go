@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ resource.Resource = &SweegoDomainIpPoolAssignmentResource{}
+var _ resource.ResourceWithImportState = &SweegoDomainIpPoolAssignmentResource{}
+
+func NewSweegoDomainIpPoolAssignmentResource() resource.Resource {
+	return &SweegoDomainIpPoolAssignmentResource{}
+}
+
+// SweegoDomainIpPoolAssignmentResource defines the resource implementation.
+type SweegoDomainIpPoolAssignmentResource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoDomainIpPoolAssignmentResourceModel describes the resource data model.
+type SweegoDomainIpPoolAssignmentResourceModel struct {
+	ClientId   types.String `tfsdk:"client_id"`
+	DomainUuid types.String `tfsdk:"domain_uuid"`
+	IpPoolUuid types.String `tfsdk:"ip_pool_uuid"`
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_ip_pool_assignment"
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a domain to a pool of dedicated IPs. E-Mails sent through the domain will only be sent using the IPs of the pool. Destroying the assignment makes the domain use sweego's shared IPs again.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideResourceAttribute,
+			"domain_uuid": schema.StringAttribute{
+				Description: "UUID of the sweego domain (e.g. sweego_domain.my_domain.uuid)",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_pool_uuid": schema.StringAttribute{
+				Description: "UUID of the IP pool the domain should use (e.g. sweego_ip_pool.transactional.uuid)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = providerData.Api
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweegoDomainIpPoolAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).AssignDomainIpPool(data.ClientId.ValueString(), data.DomainUuid.ValueString(), sweego.SweegoDomainIpPoolAssignment{
+		IpPoolUuid: data.IpPoolUuid.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error assigning IP pool", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweegoDomainIpPoolAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignment, err := withLogger(ctx, r.api).GetDomainIpPool(data.ClientId.ValueString(), data.DomainUuid.ValueString())
+	if sweego.IsNotFound(err) || (err == nil && assignment.IpPoolUuid == "") {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP pool assignment", fmt.Sprintf("Error reading IP pool assignment: %s", err.Error()))
+		return
+	}
+
+	data.IpPoolUuid = types.StringValue(assignment.IpPoolUuid)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweegoDomainIpPoolAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).AssignDomainIpPool(data.ClientId.ValueString(), data.DomainUuid.ValueString(), sweego.SweegoDomainIpPoolAssignment{
+		IpPoolUuid: data.IpPoolUuid.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error assigning IP pool", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweegoDomainIpPoolAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).UnassignDomainIpPool(data.ClientId.ValueString(), data.DomainUuid.ValueString())
+	if err != nil && !sweego.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error removing IP pool assignment", fmt.Sprintf("Error removing IP pool assignment: %s", err.Error()))
+	}
+}
+
+func (r *SweegoDomainIpPoolAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clientId, ids, err := parseImportId(req.ID, "domain_uuid", 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	if clientId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_uuid"), ids[0])...)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+const (
+	// refreshModeFull reads every domain using GetDomain.
+	refreshModeFull = "full"
+	// refreshModeListOnly serves verified domains from a single ListDomains request per client.
+	refreshModeListOnly = "list_only"
+)
+
+var refreshModes = []string{refreshModeFull, refreshModeListOnly}
+
+// domainListCache fetches the domains of a client once per provider run and shares the result between
+// all sweego_domain resources, in order to avoid a GetDomain request per domain on refresh.
+type domainListCache struct {
+	mutex sync.Mutex
+	// domains are keyed by client ID and UUID
+	domains map[string]map[string]sweego.SweegoDomainListInformation
+}
+
+func newDomainListCache() *domainListCache {
+	return &domainListCache{
+		domains: map[string]map[string]sweego.SweegoDomainListInformation{},
+	}
+}
+
+// Get returns the list information of the domain. The domains of the client are fetched on the first
+// call for the client, concurrent calls wait for it. ok is false if the domain is not listed.
+func (cache *domainListCache) Get(api sweego.SweegoDomainsApi, clientId string, uuid string) (sweego.SweegoDomainListInformation, bool, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	domains, fetched := cache.domains[clientId]
+	if !fetched {
+		list, err := api.ListDomains(clientId)
+		if err != nil {
+			return sweego.SweegoDomainListInformation{}, false, err
+		}
+
+		domains = map[string]sweego.SweegoDomainListInformation{}
+		for _, domain := range list {
+			domains[domain.Uuid] = domain
+		}
+		cache.domains[clientId] = domains
+	}
+
+	domain, ok := domains[uuid]
+	return domain, ok, nil
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// domainRecordsAttributeTypes are the attributes of a sweego_domain that are needed in order to
+// determine the DNS records of the domain. A whole sweego_domain resource can be passed wherever
+// an object of this type is expected - additional attributes are dropped by terraform.
+func domainRecordsAttributeTypes() map[string]attr.Type {
+	recordType := types.ObjectType{AttrTypes: dnsRecordAttributeTypes()}
+
+	return map[string]attr.Type{
+		"domain":              types.StringType,
+		"domain_record":       recordType,
+		"dkim_record":         recordType,
+		"next_dkim_record":    recordType,
+		"dmarc_record":        recordType,
+		"tracking_record":     recordType,
+		"inbound_record_list": types.ListType{ElemType: recordType},
+	}
+}
+
+type domainRecordsModel struct {
+	Domain            types.String `tfsdk:"domain"`
+	DomainRecord      types.Object `tfsdk:"domain_record"`
+	DkimRecord        types.Object `tfsdk:"dkim_record"`
+	NextDkimRecord    types.Object `tfsdk:"next_dkim_record"`
+	DmarcRecord       types.Object `tfsdk:"dmarc_record"`
+	TrackingRecord    types.Object `tfsdk:"tracking_record"`
+	InboundRecordList types.List   `tfsdk:"inbound_record_list"`
+}
+
+type dnsRecordModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	Data types.String `tfsdk:"data"`
+}
+
+// domainDetailsFromObject converts an object of the type described by domainRecordsAttributeTypes
+// back to the API representation. Null records are converted to empty records.
+func domainDetailsFromObject(ctx context.Context, object types.Object) (sweego.SweegoDomainDetails, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var model domainRecordsModel
+
+	diagnostics.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diagnostics.HasError() {
+		return sweego.SweegoDomainDetails{}, diagnostics
+	}
+
+	details := sweego.SweegoDomainDetails{
+		Domain:         model.Domain.ValueString(),
+		DomainRecord:   recordFromObject(ctx, model.DomainRecord, &diagnostics),
+		DkimRecord:     recordFromObject(ctx, model.DkimRecord, &diagnostics),
+		NextDkimRecord: recordFromObject(ctx, model.NextDkimRecord, &diagnostics),
+		DmarcRecord:    recordFromObject(ctx, model.DmarcRecord, &diagnostics),
+		TrackingRecord: recordFromObject(ctx, model.TrackingRecord, &diagnostics),
+	}
+
+	inboundRecords := []types.Object{}
+	if !model.InboundRecordList.IsNull() && !model.InboundRecordList.IsUnknown() {
+		diagnostics.Append(model.InboundRecordList.ElementsAs(ctx, &inboundRecords, false)...)
+	}
+	for _, record := range inboundRecords {
+		details.InboundRecordList = append(details.InboundRecordList, recordFromObject(ctx, record, &diagnostics))
+	}
+
+	return details, diagnostics
+}
+
+func recordFromObject(ctx context.Context, object types.Object, diagnostics *diag.Diagnostics) sweego.SweegoDomainRecord {
+	if object.IsNull() || object.IsUnknown() {
+		return sweego.SweegoDomainRecord{}
+	}
+
+	var model dnsRecordModel
+	diagnostics.Append(object.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+
+	return sweego.SweegoDomainRecord{
+		Name: model.Name.ValueString(),
+		Type: model.Type.ValueString(),
+		Data: model.Data.ValueString(),
+	}
+}
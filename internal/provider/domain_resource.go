@@ -3,19 +3,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/j6s/terraform-provider-sweego-provider/internal/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/internal/dnscheck"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
 )
 
 var _ resource.Resource = &SweegoDomainResource{}
 var _ resource.ResourceWithImportState = &SweegoDomainResource{}
+var _ resource.ResourceWithValidateConfig = &SweegoDomainResource{}
+var _ resource.ResourceWithModifyPlan = &SweegoDomainResource{}
 
 func NewSweegoDomainResource() resource.Resource {
 	return &SweegoDomainResource{}
@@ -23,21 +28,31 @@ func NewSweegoDomainResource() resource.Resource {
 
 // SweegoDomainResource defines the resource implementation.
 type SweegoDomainResource struct {
-	api *sweego.SweegoApi
+	api          sweego.SweegoDomainsApi
+	dnsPreflight *dnscheck.Checker
+	domainList   *domainListCache
 }
 
 // SweegoDomainResourceModel describes the resource data model.
 type SweegoDomainResourceModel struct {
+	ClientId             types.String `tfsdk:"client_id"`
 	Uuid                 types.String `tfsdk:"uuid"`
 	IsVerified           types.Bool   `tfsdk:"is_verified"`
 	OpenTrackingEnabled  types.Bool   `tfsdk:"open_tracking_enabled"`
 	ClickTrackingEnabled types.Bool   `tfsdk:"click_tracking_enabled"`
+	TrackingSubdomain    types.String `tfsdk:"tracking_subdomain"`
+	TrackingHttpsEnabled types.Bool   `tfsdk:"tracking_https_enabled"`
 	Domain               types.String `tfsdk:"domain"`
 	DomainRecord         types.Object `tfsdk:"domain_record"`
 	DkimRecord           types.Object `tfsdk:"dkim_record"`
+	NextDkimRecord       types.Object `tfsdk:"next_dkim_record"`
+	DkimRotationTrigger  types.String `tfsdk:"dkim_rotation_trigger"`
+	RecheckOnRefresh     types.Bool   `tfsdk:"recheck_on_refresh"`
 	DmarcRecord          types.Object `tfsdk:"dmarc_record"`
+	DmarcPolicy          types.Object `tfsdk:"dmarc_policy"`
 	InboundRecordList    types.List   `tfsdk:"inbound_record_list"`
 	TrackingRecord       types.Object `tfsdk:"tracking_record"`
+	ZoneFileSnippet      types.String `tfsdk:"zone_file_snippet"`
 }
 
 func (r *SweegoDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +79,7 @@ func (r *SweegoDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 		Description: "Sweego E-Mail Domain. After creation, this will provide you with a list of DNS Records. When these records are added, the domain can be used as an SMTP-Relay.",
 
 		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideResourceAttribute,
 			"domain": schema.StringAttribute{
 				Description: "Domain name of the domain that should be managed (e.g. my-domain.eu)",
 				Required:    true,
@@ -79,6 +95,18 @@ func (r *SweegoDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 				Description: "Whether or not open tracking should be enabled (defaults to false)",
 				Optional:    true,
 			},
+			"tracking_subdomain": schema.StringAttribute{
+				Description: "Subdomain of the domain tracked links and open pixels point to (e.g. links.my-domain.eu). Changing it changes the name of `tracking_record`. Defaults to the tracking domain chosen by sweego, removing it keeps the current subdomain.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tracking_https_enabled": schema.BoolAttribute{
+				Description: "Whether tracked links should use HTTPS (defaults to false). sweego can only issue the certificate once `tracking_record` is published.",
+				Optional:    true,
+			},
 			"uuid": schema.StringAttribute{
 				Description: "UUID of the domain in sweego's system.",
 				Computed:    true,
@@ -100,11 +128,25 @@ func (r *SweegoDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 				Attributes:  dnsRecordAttributes,
 			},
+			"next_dkim_record": schema.SingleNestedAttribute{
+				Description: "New DKIM DNS Record while a DKIM rotation is in progress. It needs to be published in addition to `dkim_record`. Once sweego has verified it, the next apply completes the rotation and it replaces `dkim_record`.",
+				Computed:    true,
+				Attributes:  dnsRecordAttributes,
+			},
+			"dkim_rotation_trigger": schema.StringAttribute{
+				Description: "Changing this value rotates the DKIM key of the domain (e.g. set it to the date of the rotation). Setting it when creating the domain does not trigger a rotation.",
+				Optional:    true,
+			},
+			"recheck_on_refresh": schema.BoolAttribute{
+				Description: "Whether sweego should verify the DNS records again on every refresh (defaults to false). By default, records are only verified by sweego on create and update, refreshing only reads the verification status of the last check.",
+				Optional:    true,
+			},
 			"dmarc_record": schema.SingleNestedAttribute{
-				Description: "DMARC DNS Record that needs to be set in order to send E-Mails",
+				Description: "DMARC DNS Record that needs to be set in order to send E-Mails. If `dmarc_policy` is set, the record is built from the policy.",
 				Computed:    true,
 				Attributes:  dnsRecordAttributes,
 			},
+			"dmarc_policy": dmarcPolicyAttribute,
 			"inbound_record_list": schema.ListNestedAttribute{
 				Description: "List of DNS Records that need to be set, if sweego should accept E-Mails",
 				Computed:    true,
@@ -117,6 +159,10 @@ func (r *SweegoDomainResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:    true,
 				Attributes:  dnsRecordAttributes,
 			},
+			"zone_file_snippet": schema.StringAttribute{
+				Description: "All DNS Records that need to be set, formatted as BIND zone file snippet. Other formats can be rendered using the render_records function.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -126,18 +172,44 @@ func (r *SweegoDomainResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	api, ok := req.ProviderData.(*sweego.SweegoApi)
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sweego.SweegoApi, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.api = api
+	r.api = providerData.Api
+	r.dnsPreflight = providerData.DnsPreflight
+	r.domainList = providerData.DomainList
+}
+
+func (r *SweegoDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SweegoDomainResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dmarcRecordFromObject(ctx, data.DmarcPolicy, &resp.Diagnostics)
+
+	if !data.TrackingSubdomain.IsNull() && !data.TrackingSubdomain.IsUnknown() && !data.Domain.IsNull() && !data.Domain.IsUnknown() {
+		subdomain := strings.ToLower(sweego.StripTrailingDot(data.TrackingSubdomain.ValueString()))
+		domain := strings.ToLower(sweego.StripTrailingDot(data.Domain.ValueString()))
+		if !strings.HasSuffix(subdomain, "."+domain) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tracking_subdomain"),
+				"Invalid tracking subdomain",
+				fmt.Sprintf("The tracking subdomain must be a subdomain of %s (e.g. links.%s), got: %#v", domain, domain, data.TrackingSubdomain.ValueString()),
+			)
+		}
+	}
 }
 
 func (r *SweegoDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -149,7 +221,7 @@ func (r *SweegoDomainResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	api := r.api.WithLogger(NewLoggerAdapter(ctx))
+	api := withLogger(ctx, r.api)
 
 	// Creation is a bit of a journey:
 	// * Only the result of the creation request will contain the UUID of the domain
@@ -160,30 +232,29 @@ func (r *SweegoDomainResource) Create(ctx context.Context, req resource.CreateRe
 	// * Create the domain
 	// * Update tracking settings
 	// * Read back the domain state, but use the UUID from the creation response.
-	createdDomain, err := api.CreateDomain(data.Domain.ValueString())
+	createdDomain, err := api.CreateDomain(data.ClientId.ValueString(), data.Domain.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating domain", err.Error())
 		return
 	}
 
-	err = api.UpdateTracking(createdDomain.Uuid, sweego.SweegoTrackingChangeRequest{
-		OpenTrackingEnabled:  data.OpenTrackingEnabled.ValueBool(),
-		ClickTrackingEnabled: data.ClickTrackingEnabled.ValueBool(),
-	})
+	err = api.UpdateTracking(data.ClientId.ValueString(), createdDomain.Uuid, trackingChangeRequest(data))
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating tracking settings", err.Error())
 		return
 	}
 
-	domain, err := api.GetDomain(createdDomain.Uuid)
+	domain, err := api.GetDomain(data.ClientId.ValueString(), createdDomain.Uuid)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading back domain status", err.Error())
 		return
 	}
 	domain.Uuid = createdDomain.Uuid
+	applyDmarcPolicy(ctx, data, &domain, &resp.Diagnostics)
 
 	data = r.fillStateFromResponse(domain, data)
-	checkDomain(api, data, resp.Diagnostics)
+	checkDomain(api, data, &resp.Diagnostics)
+	r.preflightDomain(ctx, domain, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -199,15 +270,33 @@ func (r *SweegoDomainResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	api := r.api.WithLogger(NewLoggerAdapter(ctx))
-	domain, err := api.GetDomain(data.Uuid.ValueString())
+	api := withLogger(ctx, r.api)
+	if r.refreshFromList(api, &data, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	domain, err := api.GetDomain(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if sweego.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading domain", fmt.Sprintf("Error reading domain: %s", err.Error()))
 		return
 	}
+	reportDkimRotation(domain, &resp.Diagnostics)
+	applyDmarcPolicy(ctx, data, &domain, &resp.Diagnostics)
 
 	data = r.fillStateFromResponse(domain, data)
-	checkDomain(api, data, resp.Diagnostics)
+	if data.RecheckOnRefresh.ValueBool() {
+		checkDomain(api, data, &resp.Diagnostics)
+	} else {
+		logUnverifiedRecords(data.Domain.ValueString(), domain.Verification(), &resp.Diagnostics)
+	}
+	r.preflightDomain(ctx, domain, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -215,8 +304,10 @@ func (r *SweegoDomainResource) Read(ctx context.Context, req resource.ReadReques
 
 func (r *SweegoDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data SweegoDomainResourceModel
+	var state SweegoDomainResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -224,25 +315,33 @@ func (r *SweegoDomainResource) Update(ctx context.Context, req resource.UpdateRe
 
 	NewLoggerAdapter(ctx).Info(fmt.Sprintf("%#v", data))
 
-	api := r.api.WithLogger(NewLoggerAdapter(ctx))
+	api := withLogger(ctx, r.api)
 
-	err := api.UpdateTracking(data.Uuid.ValueString(), sweego.SweegoTrackingChangeRequest{
-		OpenTrackingEnabled:  data.OpenTrackingEnabled.ValueBool(),
-		ClickTrackingEnabled: data.ClickTrackingEnabled.ValueBool(),
-	})
+	err := api.UpdateTracking(data.ClientId.ValueString(), data.Uuid.ValueString(), trackingChangeRequest(data))
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating tracking settings", err.Error())
 		return
 	}
 
-	domain, err := api.GetDomain(data.Uuid.ValueString())
+	if !data.DkimRotationTrigger.IsNull() && !data.DkimRotationTrigger.Equal(state.DkimRotationTrigger) {
+		_, err = api.RotateDkim(data.ClientId.ValueString(), data.Uuid.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating DKIM key", err.Error())
+			return
+		}
+	}
+
+	domain, err := api.GetDomain(data.ClientId.ValueString(), data.Uuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading back domain status", err.Error())
 		return
 	}
+	domain = completeDkimRotation(api, data, domain, &resp.Diagnostics)
+	applyDmarcPolicy(ctx, data, &domain, &resp.Diagnostics)
 
 	data = r.fillStateFromResponse(domain, data)
-	checkDomain(api, data, resp.Diagnostics)
+	checkDomain(api, data, &resp.Diagnostics)
+	r.preflightDomain(ctx, domain, &resp.Diagnostics)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -258,23 +357,35 @@ func (r *SweegoDomainResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.api.WithLogger(NewLoggerAdapter(ctx)).DeleteDomain(data.Uuid.ValueString())
+	err := withLogger(ctx, r.api).DeleteDomain(data.ClientId.ValueString(), data.Uuid.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting domain", fmt.Sprintf("Error deleting domain: %s", err.Error()))
 	}
 }
 
 func (r *SweegoDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	api := r.api.WithLogger(NewLoggerAdapter(ctx))
+	clientId, ids, err := parseImportId(req.ID, "uuid", 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	api := withLogger(ctx, r.api)
 
-	domain, err := api.GetDomain(req.ID)
+	domain, err := api.GetDomain(clientId, ids[0])
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading domain", fmt.Sprintf("Error reading domain: %s", err.Error()))
+		return
 	}
 
-	data := r.fillStateFromResponse(domain, SweegoDomainResourceModel{})
-	data.Uuid = types.StringValue(req.ID)
-	checkDomain(api, data, resp.Diagnostics)
+	data := r.fillStateFromResponse(domain, SweegoDomainResourceModel{
+		DmarcPolicy: types.ObjectNull(dmarcPolicyAttributeTypes()),
+	})
+	data.Uuid = types.StringValue(ids[0])
+	if clientId != "" {
+		data.ClientId = types.StringValue(clientId)
+	}
+	logUnverifiedRecords(data.Domain.ValueString(), domain.Verification(), &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -287,62 +398,253 @@ func (r *SweegoDomainResource) fillStateFromResponse(response sweego.SweegoDomai
 	state.IsVerified = types.BoolValue(response.IsVerified)
 	state.DomainRecord = recordToObject(response.DomainRecord)
 	state.DkimRecord = recordToObject(response.DkimRecord)
+	state.NextDkimRecord = types.ObjectNull(dnsRecordAttributeTypes())
+	if response.NextDkimRecord.Data != "" {
+		state.NextDkimRecord = recordToObject(response.NextDkimRecord)
+	}
 	state.DmarcRecord = recordToObject(response.DmarcRecord)
 	state.TrackingRecord = recordToObject(response.TrackingRecord)
+	state.TrackingSubdomain = types.StringValue(response.TrackingSubdomain)
+	// Tracking settings that are not configured stay null, unless enabled remotely
+	if !state.OpenTrackingEnabled.IsNull() || response.TrackingOpenEnabled {
+		state.OpenTrackingEnabled = types.BoolValue(response.TrackingOpenEnabled)
+	}
+	if !state.ClickTrackingEnabled.IsNull() || response.TrackingClickEnabled {
+		state.ClickTrackingEnabled = types.BoolValue(response.TrackingClickEnabled)
+	}
+	if !state.TrackingHttpsEnabled.IsNull() || response.TrackingHttpsEnabled {
+		state.TrackingHttpsEnabled = types.BoolValue(response.TrackingHttpsEnabled)
+	}
 
 	recordList := make([]attr.Value, len(response.InboundRecordList))
 	for i, record := range response.InboundRecordList {
 		recordList[i] = recordToObject(record)
 	}
 
-	typeMap := map[string]attr.Type{}
-	for key, value := range dnsRecordAttributes {
-		typeMap[key] = value.GetType()
-	}
-
 	state.InboundRecordList = types.ListValueMust(types.ObjectType{
-		AttrTypes: typeMap,
+		AttrTypes: dnsRecordAttributeTypes(),
 	}, recordList)
 
+	zoneFileSnippet, _ := response.RenderRecords(sweego.RecordFormatBind)
+	state.ZoneFileSnippet = types.StringValue(zoneFileSnippet)
+
 	return state
 }
 
-func recordToObject(record sweego.SweegoDomainRecord) types.Object {
+// trackingChangeRequest returns the tracking settings of the plan. Unknown values (e.g. tracking_subdomain
+// if it is not configured on creation) are not sent, so sweego keeps its current setting.
+func trackingChangeRequest(data SweegoDomainResourceModel) sweego.SweegoTrackingChangeRequest {
+	request := sweego.SweegoTrackingChangeRequest{
+		OpenTrackingEnabled:  data.OpenTrackingEnabled.ValueBool(),
+		ClickTrackingEnabled: data.ClickTrackingEnabled.ValueBool(),
+	}
+	if !data.TrackingSubdomain.IsUnknown() && !data.TrackingSubdomain.IsNull() {
+		request.TrackingSubdomain = data.TrackingSubdomain.ValueStringPointer()
+	}
+	if !data.TrackingHttpsEnabled.IsUnknown() {
+		httpsEnabled := data.TrackingHttpsEnabled.ValueBool()
+		request.HttpsEnabled = &httpsEnabled
+	}
+	return request
+}
+
+// refreshFromList updates the list-level attributes of a verified domain from the cached domain list
+// (refresh_mode = "list_only") and keeps the records from the state. It returns false if the domain
+// needs to be read in full: If the list cache is disabled, the domain is not verified, not listed,
+// has a DKIM rotation in progress or its tracking subdomain changed.
+func (r *SweegoDomainResource) refreshFromList(api sweego.SweegoDomainsApi, data *SweegoDomainResourceModel, diagnostics *diag.Diagnostics) bool {
+	if r.domainList == nil || !data.IsVerified.ValueBool() || !data.NextDkimRecord.IsNull() || data.DomainRecord.IsNull() {
+		return false
+	}
+
+	domain, ok, err := r.domainList.Get(api, data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil {
+		diagnostics.AddError("Error listing domains", fmt.Sprintf("Error listing domains: %s", err.Error()))
+		return true
+	}
+	if !ok || !domain.IsVerified {
+		return false
+	}
+	// The tracking record is published at the tracking subdomain, so it needs to be read if the subdomain changed
+	if !data.TrackingSubdomain.IsNull() && !data.TrackingSubdomain.IsUnknown() && data.TrackingSubdomain.ValueString() != domain.TrackingSubdomain {
+		return false
+	}
+
+	data.Domain = types.StringValue(domain.Domain)
+	data.IsVerified = types.BoolValue(domain.IsVerified)
+	// Tracking settings that are not configured stay null (as after a full read), unless enabled remotely
+	if !data.OpenTrackingEnabled.IsNull() || domain.TrackingOpenEnabled {
+		data.OpenTrackingEnabled = types.BoolValue(domain.TrackingOpenEnabled)
+	}
+	if !data.ClickTrackingEnabled.IsNull() || domain.TrackingClickEnabled {
+		data.ClickTrackingEnabled = types.BoolValue(domain.TrackingClickEnabled)
+	}
+	if !data.TrackingHttpsEnabled.IsNull() || domain.TrackingHttpsEnabled {
+		data.TrackingHttpsEnabled = types.BoolValue(domain.TrackingHttpsEnabled)
+	}
+	data.TrackingSubdomain = types.StringValue(domain.TrackingSubdomain)
+	return true
+}
+
+// ModifyPlan plans an update of domains with a verified DKIM rotation, so the rotation is completed by the
+// next apply (refreshing the domain does not change it). The records are unknown until the update.
+func (r *SweegoDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.api == nil {
+		return
+	}
+
+	var state SweegoDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.NextDkimRecord.IsNull() {
+		return
+	}
+
+	domain, err := withLogger(ctx, r.api).GetDomain(state.ClientId.ValueString(), state.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain", fmt.Sprintf("Error reading domain: %s", err.Error()))
+		return
+	}
+	if domain.NextDkimRecord.Data == "" || !domain.NextDkimRecord.Verified {
+		return
+	}
+
+	recordType := types.ObjectUnknown(dnsRecordAttributeTypes())
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_verified"), types.BoolUnknown())...)
+	for _, attribute := range []string{"domain_record", "dkim_record", "next_dkim_record", "dmarc_record", "tracking_record"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(attribute), recordType)...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("inbound_record_list"), types.ListUnknown(types.ObjectType{AttrTypes: dnsRecordAttributeTypes()}))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_file_snippet"), types.StringUnknown())...)
+}
+
+// reportDkimRotation warns about a DKIM rotation in progress without changing it.
+func reportDkimRotation(domain sweego.SweegoDomainDetails, diagnostics *diag.Diagnostics) {
+	if domain.NextDkimRecord.Data == "" {
+		return
+	}
+
+	if domain.NextDkimRecord.Verified {
+		diagnostics.AddWarning(
+			"DKIM rotation ready to complete",
+			fmt.Sprintf("The new DKIM key of %s is verified. The next terraform apply retires the old key and replaces dkim_record by next_dkim_record.", domain.Domain),
+		)
+		return
+	}
+	diagnostics.AddWarning(
+		"DKIM rotation in progress",
+		fmt.Sprintf("The new DKIM key of %s is not verified yet. Publish next_dkim_record in addition to dkim_record in order to complete the rotation.", domain.Domain),
+	)
+}
+
+// completeDkimRotation retires the old DKIM key once the key of a running rotation has been verified and
+// returns the domain with the new key as DKIM record. It is only called on update, see ModifyPlan.
+func completeDkimRotation(api sweego.SweegoDomainsApi, data SweegoDomainResourceModel, domain sweego.SweegoDomainDetails, diagnostics *diag.Diagnostics) sweego.SweegoDomainDetails {
+	if domain.NextDkimRecord.Data == "" {
+		return domain
+	}
+
+	if !domain.NextDkimRecord.Verified {
+		reportDkimRotation(domain, diagnostics)
+		return domain
+	}
+
+	err := api.CompleteDkimRotation(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil {
+		diagnostics.AddError("Error completing DKIM rotation", err.Error())
+		return domain
+	}
+
+	completed, err := api.GetDomain(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil {
+		diagnostics.AddError("Error reading back domain status", err.Error())
+		return domain
+	}
+	return completed
+}
+
+// applyDmarcPolicy replaces the DMARC record suggested by sweego with the record built from dmarc_policy, if set.
+func applyDmarcPolicy(ctx context.Context, data SweegoDomainResourceModel, domain *sweego.SweegoDomainDetails, diagnostics *diag.Diagnostics) {
+	record, ok := dmarcRecordFromObject(ctx, data.DmarcPolicy, diagnostics)
+	if !ok {
+		return
+	}
+
+	if domain.DmarcRecord.Name == "" {
+		domain.DmarcRecord.Name = "_dmarc"
+	}
+	domain.DmarcRecord.Type = "TXT"
+	domain.DmarcRecord.Data = record.String()
+}
+
+// dnsRecordAttributeTypes returns the attribute types of objects described by dnsRecordAttributes.
+func dnsRecordAttributeTypes() map[string]attr.Type {
 	typeMap := map[string]attr.Type{}
 	for key, value := range dnsRecordAttributes {
 		typeMap[key] = value.GetType()
 	}
-	return types.ObjectValueMust(typeMap, map[string]attr.Value{
+	return typeMap
+}
+
+func recordToObject(record sweego.SweegoDomainRecord) types.Object {
+	return types.ObjectValueMust(dnsRecordAttributeTypes(), map[string]attr.Value{
 		"type": types.StringValue(record.Type),
 		"name": types.StringValue(record.Name),
 		"data": types.StringValue(record.Data),
 	})
 }
 
+// checkDomain makes sweego verify the records of the domain and reports unverified records as warnings.
+// As this changes the verification status in sweego, it is only used on create, update and if
+// recheck_on_refresh is set.
 func checkDomain(
-	api *sweego.SweegoApi,
+	api sweego.SweegoDomainsApi,
 	data SweegoDomainResourceModel,
-	diagnostics diag.Diagnostics,
+	diagnostics *diag.Diagnostics,
 ) {
-	check, err := api.Check(data.Uuid.ValueString())
+	check, err := api.Check(data.ClientId.ValueString(), data.Uuid.ValueString())
 	if err != nil {
 		diagnostics.AddError("Error checking domain status", fmt.Sprintf("Error checking domain status: %s", err.Error()))
 	} else {
-		logUnverifiedDomain(data.Domain.ValueString(), "DKIM", check.DkimRecord, diagnostics)
-		logUnverifiedDomain(data.Domain.ValueString(), "DMARC", check.DmarcRecord, diagnostics)
-		logUnverifiedDomain(data.Domain.ValueString(), "SPF", check.SpfRecord, diagnostics)
-		logUnverifiedDomain(data.Domain.ValueString(), "Tracking", check.TrackingRecord, diagnostics)
-		for i, checkResult := range check.InboundRecordList {
-			logUnverifiedDomain(data.Domain.ValueString(), fmt.Sprintf("Tracking[%d]", i), checkResult, diagnostics)
-		}
+		logUnverifiedRecords(data.Domain.ValueString(), check, diagnostics)
+	}
+}
+
+func logUnverifiedRecords(domain string, check sweego.SweegoDomainCheckResult, diagnostics *diag.Diagnostics) {
+	for _, checkResult := range check.Records() {
+		logUnverifiedDomain(domain, checkResult.Record, checkResult.SweegoDomainCheckSingleResult, diagnostics)
 	}
 }
 
-func logUnverifiedDomain(domain string, recordType string, checkResult sweego.SweegoDomainCheckSingleResult, diagnostics diag.Diagnostics) {
+func logUnverifiedDomain(domain string, recordType string, checkResult sweego.SweegoDomainCheckSingleResult, diagnostics *diag.Diagnostics) {
 	if !checkResult.Verified {
+		reason := checkResult.ErrorString
+		if reason == "" {
+			reason = "Not verified by the last check"
+		}
 		diagnostics.AddWarning(
 			"DNS Record not verified",
-			fmt.Sprintf("Domain %s does not have a sweego-verified %s Record: %s\nIn order to ensure verification, use the DNS-Record information returned by the resource to create a record with your DNS-Provider", domain, recordType, checkResult.ErrorString),
+			fmt.Sprintf("Domain %s does not have a sweego-verified %s Record: %s\nIn order to ensure verification, use the DNS-Record information returned by the resource to create a record with your DNS-Provider", domain, recordType, reason),
+		)
+	}
+}
+
+// preflightDomain resolves the required records of the domain against the nameservers configured in the
+// provider and reports problems as warnings. Nothing happens if the pre-flight check is not enabled.
+func (r *SweegoDomainResource) preflightDomain(ctx context.Context, domain sweego.SweegoDomainDetails, diagnostics *diag.Diagnostics) {
+	if r.dnsPreflight == nil {
+		return
+	}
+
+	mismatches, err := r.dnsPreflight.Check(ctx, domain.Domain, domain.RequiredRecords())
+	if err != nil {
+		diagnostics.AddWarning("DNS pre-flight check failed", fmt.Sprintf("DNS records of %s could not be checked: %s", domain.Domain, err.Error()))
+		return
+	}
+
+	for _, mismatch := range mismatches {
+		diagnostics.AddWarning(
+			"DNS pre-flight mismatch",
+			fmt.Sprintf("Domain %s: %s", domain.Domain, mismatch.String()),
 		)
 	}
 }
@@ -0,0 +1,544 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
+)
+
+func domainSchema(t *testing.T) resource.SchemaResponse {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	(&SweegoDomainResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", resp.Diagnostics)
+	}
+	return resp
+}
+
+// plannedDomain returns the plan terraform creates for a new domain without optional attributes.
+func plannedDomain(domain string) SweegoDomainResourceModel {
+	record := types.ObjectUnknown(dnsRecordAttributeTypes())
+	return SweegoDomainResourceModel{
+		ClientId:             types.StringNull(),
+		Uuid:                 types.StringUnknown(),
+		IsVerified:           types.BoolUnknown(),
+		OpenTrackingEnabled:  types.BoolNull(),
+		ClickTrackingEnabled: types.BoolNull(),
+		TrackingSubdomain:    types.StringUnknown(),
+		TrackingHttpsEnabled: types.BoolNull(),
+		Domain:               types.StringValue(domain),
+		DomainRecord:         record,
+		DkimRecord:           record,
+		NextDkimRecord:       record,
+		DkimRotationTrigger:  types.StringNull(),
+		RecheckOnRefresh:     types.BoolNull(),
+		DmarcRecord:          record,
+		DmarcPolicy:          types.ObjectNull(dmarcPolicyAttributeTypes()),
+		InboundRecordList:    types.ListUnknown(types.ObjectType{AttrTypes: dnsRecordAttributeTypes()}),
+		TrackingRecord:       record,
+		ZoneFileSnippet:      types.StringUnknown(),
+	}
+}
+
+// domainTest calls the resource methods the way terraform does, converting models from and to raw values.
+type domainTest struct {
+	t        *testing.T
+	resource *SweegoDomainResource
+	schema   resource.SchemaResponse
+}
+
+func (test domainTest) state(model *SweegoDomainResourceModel) tfsdk.State {
+	state := tfsdk.State{Schema: test.schema.Schema, Raw: tftypes.NewValue(test.schema.Schema.Type().TerraformType(context.Background()), nil)}
+	if model != nil {
+		if diagnostics := state.Set(context.Background(), model); diagnostics.HasError() {
+			test.t.Fatalf("invalid state: %v", diagnostics)
+		}
+	}
+	return state
+}
+
+func (test domainTest) plan(model SweegoDomainResourceModel) tfsdk.Plan {
+	state := test.state(&model)
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+// model returns the model of the state, or nil if the resource has been removed.
+func (test domainTest) model(state tfsdk.State) *SweegoDomainResourceModel {
+	if state.Raw.IsNull() {
+		return nil
+	}
+	var model SweegoDomainResourceModel
+	if diagnostics := state.Get(context.Background(), &model); diagnostics.HasError() {
+		test.t.Fatalf("invalid state: %v", diagnostics)
+	}
+	return &model
+}
+
+func (test domainTest) create(plan SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+	resp := &resource.CreateResponse{State: test.state(nil)}
+	test.resource.Create(context.Background(), resource.CreateRequest{Plan: test.plan(plan)}, resp)
+	return test.model(resp.State), resp.Diagnostics
+}
+
+func (test domainTest) read(prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+	resp := &resource.ReadResponse{State: test.state(&prior)}
+	test.resource.Read(context.Background(), resource.ReadRequest{State: test.state(&prior)}, resp)
+	return test.model(resp.State), resp.Diagnostics
+}
+
+func (test domainTest) update(prior SweegoDomainResourceModel, plan SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+	resp := &resource.UpdateResponse{State: test.state(&prior)}
+	test.resource.Update(context.Background(), resource.UpdateRequest{State: test.state(&prior), Plan: test.plan(plan)}, resp)
+	return test.model(resp.State), resp.Diagnostics
+}
+
+func (test domainTest) delete(prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+	resp := &resource.DeleteResponse{State: test.state(&prior)}
+	test.resource.Delete(context.Background(), resource.DeleteRequest{State: test.state(&prior)}, resp)
+	return test.model(resp.State), resp.Diagnostics
+}
+
+func (test domainTest) modifyPlan(prior SweegoDomainResourceModel, plan SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+	resp := &resource.ModifyPlanResponse{Plan: test.plan(plan)}
+	test.resource.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: test.state(&prior), Plan: test.plan(plan)}, resp)
+	return test.model(tfsdk.State(resp.Plan)), resp.Diagnostics
+}
+
+// rotateDkim starts a DKIM rotation of the first domain and stores the new key in the prior state.
+func rotateDkim(verified bool) func(*testing.T, *sweegomock.DomainsApi, *SweegoDomainResourceModel) {
+	return func(t *testing.T, api *sweegomock.DomainsApi, prior *SweegoDomainResourceModel) {
+		uuid := sweegomock.DomainUuid(1)
+		next, err := api.RotateDkim("", uuid)
+		if err != nil {
+			t.Fatalf("cannot rotate DKIM key: %s", err)
+		}
+		domain := api.Domains[uuid]
+		domain.NextDkimRecord.Verified = verified
+		api.Domains[uuid] = domain
+		prior.NextDkimRecord = recordToObject(next)
+	}
+}
+
+func recordData(t *testing.T, record types.Object) string {
+	t.Helper()
+	if record.IsNull() || record.IsUnknown() {
+		return ""
+	}
+	return record.Attributes()["data"].(types.String).ValueString()
+}
+
+func TestDomainResource(t *testing.T) {
+	uuid := sweegomock.DomainUuid(1)
+
+	tests := []struct {
+		name string
+		// existing creates the domain example.com before the test, passing its state as prior state
+		existing bool
+		// listOnly configures refresh_mode = "list_only"
+		listOnly bool
+		setup    func(*testing.T, *sweegomock.DomainsApi, *SweegoDomainResourceModel)
+		run      func(domainTest, SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics)
+		// calls are the expected API calls of the test (excluding the creation of the existing domain)
+		calls       []string
+		diagnostics []string
+		check       func(*testing.T, *sweegomock.DomainsApi, *SweegoDomainResourceModel)
+	}{
+		{
+			name: "create",
+			run: func(test domainTest, _ SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.create(plannedDomain("example.com"))
+			},
+			calls: []string{"CreateDomain", "UpdateTracking", "GetDomain", "Check"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state.Uuid.ValueString() != uuid || state.Domain.ValueString() != "example.com" {
+					t.Errorf("unexpected domain %s (%s)", state.Domain, state.Uuid)
+				}
+				if recordData(t, state.DomainRecord) != uuid+".domains.sweego.io." || recordData(t, state.DkimRecord) != uuid+".dkim.sweego.io." {
+					t.Errorf("unexpected records %s, %s", state.DomainRecord, state.DkimRecord)
+				}
+				if !state.NextDkimRecord.IsNull() || len(state.InboundRecordList.Elements()) != 1 {
+					t.Errorf("unexpected records %s, %s", state.NextDkimRecord, state.InboundRecordList)
+				}
+				snippet, _ := api.Domains[uuid].RenderRecords(sweego.RecordFormatBind)
+				if !strings.Contains(snippet, "swg.example.com.") || state.ZoneFileSnippet.ValueString() != snippet {
+					t.Errorf("unexpected zone file snippet %s, expected %#v", state.ZoneFileSnippet, snippet)
+				}
+			},
+		},
+		{
+			name: "create with tracking settings",
+			run: func(test domainTest, _ SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				plan := plannedDomain("example.com")
+				plan.OpenTrackingEnabled = types.BoolValue(true)
+				plan.ClickTrackingEnabled = types.BoolValue(true)
+				plan.TrackingSubdomain = types.StringValue("links.example.com")
+				plan.TrackingHttpsEnabled = types.BoolValue(true)
+				return test.create(plan)
+			},
+			calls: []string{"CreateDomain", "UpdateTracking", "GetDomain", "Check"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.TrackingHttpsEnabled.ValueBool() {
+					t.Errorf("expected tracking_https_enabled to be read back, got %s", state.TrackingHttpsEnabled)
+				}
+				domain := api.Domains[uuid]
+				if !domain.TrackingOpenEnabled || !domain.TrackingClickEnabled || !domain.TrackingHttpsEnabled || domain.TrackingSubdomain != "links.example.com" {
+					t.Errorf("unexpected tracking settings %#v", domain)
+				}
+				if state.TrackingSubdomain.ValueString() != "links.example.com" || state.TrackingRecord.Attributes()["name"].(types.String).ValueString() != "links" {
+					t.Errorf("unexpected tracking state %s, %s", state.TrackingSubdomain, state.TrackingRecord)
+				}
+			},
+		},
+		{
+			name: "create does not send unknown tracking subdomain",
+			run: func(test domainTest, _ SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				state, diagnostics := test.create(plannedDomain("example.com"))
+				for _, call := range test.resource.api.(*sweegomock.DomainsApi).Calls {
+					if tracking, ok := call.Args[len(call.Args)-1].(sweego.SweegoTrackingChangeRequest); ok && tracking.TrackingSubdomain != nil {
+						test.t.Errorf("expected the unknown tracking subdomain not to be sent, got %#v", *tracking.TrackingSubdomain)
+					}
+				}
+				return state, diagnostics
+			},
+			calls: []string{"CreateDomain", "UpdateTracking", "GetDomain", "Check"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state.TrackingSubdomain.ValueString() != "" || !state.TrackingHttpsEnabled.IsNull() {
+					t.Errorf("unexpected tracking state %s, %s", state.TrackingSubdomain, state.TrackingHttpsEnabled)
+				}
+			},
+		},
+		{
+			name: "create with unverified records",
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				api.CheckResults[uuid] = sweego.SweegoDomainCheckResult{
+					DomainRecord:   sweego.SweegoDomainCheckSingleResult{Verified: true},
+					DkimRecord:     sweego.SweegoDomainCheckSingleResult{ErrorString: "CNAME not found"},
+					DmarcRecord:    sweego.SweegoDomainCheckSingleResult{Verified: true},
+					TrackingRecord: sweego.SweegoDomainCheckSingleResult{Verified: true},
+				}
+			},
+			run: func(test domainTest, _ SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.create(plannedDomain("example.com"))
+			},
+			calls:       []string{"CreateDomain", "UpdateTracking", "GetDomain", "Check"},
+			diagnostics: []string{"Warning: DNS Record not verified"},
+		},
+		{
+			name: "create fails",
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				api.Errors["CreateDomain"] = errors.New("domain already exists")
+			},
+			run: func(test domainTest, _ SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.create(plannedDomain("example.com"))
+			},
+			calls:       []string{"CreateDomain"},
+			diagnostics: []string{"Error: Error creating domain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state != nil {
+					t.Errorf("expected no state, got %#v", state)
+				}
+			},
+		},
+		{
+			name:     "read",
+			existing: true,
+			run:      domainTest.read,
+			calls:    []string{"GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.IsVerified.ValueBool() {
+					t.Errorf("expected the verification status of the last check")
+				}
+				if !state.OpenTrackingEnabled.IsNull() || !state.ClickTrackingEnabled.IsNull() {
+					t.Errorf("expected unconfigured tracking settings to stay null")
+				}
+			},
+		},
+		{
+			name:     "read with recheck_on_refresh",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, prior *SweegoDomainResourceModel) {
+				prior.RecheckOnRefresh = types.BoolValue(true)
+			},
+			run:   domainTest.read,
+			calls: []string{"GetDomain", "Check"},
+		},
+		{
+			name:     "read with DKIM rotation in progress",
+			existing: true,
+			setup:    rotateDkim(false),
+			run:      domainTest.read,
+			calls:    []string{"GetDomain"},
+			diagnostics: []string{
+				"Warning: DKIM rotation in progress",
+			},
+		},
+		{
+			name:        "read does not complete verified DKIM rotation",
+			existing:    true,
+			setup:       rotateDkim(true),
+			run:         domainTest.read,
+			calls:       []string{"GetDomain"},
+			diagnostics: []string{"Warning: DKIM rotation ready to complete"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state.NextDkimRecord.IsNull() || recordData(t, state.DkimRecord) != uuid+".dkim.sweego.io." {
+					t.Errorf("expected the rotation to stay in progress, got %s, %s", state.DkimRecord, state.NextDkimRecord)
+				}
+			},
+		},
+		{
+			name:     "read detects tracking changed remotely",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, prior *SweegoDomainResourceModel) {
+				// Open tracking is configured, but has been disabled outside of terraform
+				prior.OpenTrackingEnabled = types.BoolValue(true)
+				domain := api.Domains[uuid]
+				domain.TrackingClickEnabled = true
+				api.Domains[uuid] = domain
+			},
+			run:   domainTest.read,
+			calls: []string{"GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.OpenTrackingEnabled.Equal(types.BoolValue(false)) || !state.ClickTrackingEnabled.Equal(types.BoolValue(true)) {
+					t.Errorf("expected the remote tracking settings, got %s, %s", state.OpenTrackingEnabled, state.ClickTrackingEnabled)
+				}
+				if !state.TrackingHttpsEnabled.IsNull() {
+					t.Errorf("expected unconfigured tracking_https_enabled to stay null, got %s", state.TrackingHttpsEnabled)
+				}
+			},
+		},
+		{
+			name:     "read detects HTTPS enabled remotely",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				domain := api.Domains[uuid]
+				domain.TrackingHttpsEnabled = true
+				api.Domains[uuid] = domain
+			},
+			run:   domainTest.read,
+			calls: []string{"GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.TrackingHttpsEnabled.ValueBool() {
+					t.Errorf("expected tracking_https_enabled to be true, got %s", state.TrackingHttpsEnabled)
+				}
+			},
+		},
+		{
+			name:     "list_only refresh",
+			existing: true,
+			listOnly: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, prior *SweegoDomainResourceModel) {
+				// The state of a verified domain, as after the first refresh
+				prior.IsVerified = types.BoolValue(true)
+				domain := api.Domains[uuid]
+				domain.TrackingClickEnabled = true
+				api.Domains[uuid] = domain
+			},
+			run:   domainTest.read,
+			calls: []string{"ListDomains"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.ClickTrackingEnabled.ValueBool() || !state.OpenTrackingEnabled.IsNull() || !state.TrackingHttpsEnabled.IsNull() {
+					t.Errorf("unexpected tracking state %s, %s, %s", state.ClickTrackingEnabled, state.OpenTrackingEnabled, state.TrackingHttpsEnabled)
+				}
+				if !state.TrackingSubdomain.Equal(types.StringValue("")) {
+					t.Errorf("unexpected tracking subdomain %s", state.TrackingSubdomain)
+				}
+			},
+		},
+		{
+			name:     "list_only refresh reads domain with changed tracking subdomain",
+			existing: true,
+			listOnly: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, prior *SweegoDomainResourceModel) {
+				prior.IsVerified = types.BoolValue(true)
+				subdomain := "links.example.com"
+				if err := api.UpdateTracking("", uuid, sweego.SweegoTrackingChangeRequest{TrackingSubdomain: &subdomain}); err != nil {
+					t.Fatal(err)
+				}
+				// Verify the new tracking record, so the domain is listed as verified
+				if _, err := api.Check("", uuid); err != nil {
+					t.Fatal(err)
+				}
+			},
+			run:   domainTest.read,
+			calls: []string{"ListDomains", "GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state.TrackingSubdomain.ValueString() != "links.example.com" || state.TrackingRecord.Attributes()["name"].(types.String).ValueString() != "links" {
+					t.Errorf("expected the tracking subdomain and record to be refreshed, got %s, %s", state.TrackingSubdomain, state.TrackingRecord)
+				}
+			},
+		},
+		{
+			name:     "read removes deleted domain",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				delete(api.Domains, uuid)
+			},
+			run:   domainTest.read,
+			calls: []string{"GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if state != nil {
+					t.Errorf("expected the domain to be removed from the state, got %#v", state)
+				}
+			},
+		},
+		{
+			name:     "read fails",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				api.Errors["GetDomain"] = errors.New("internal server error")
+			},
+			run:         domainTest.read,
+			calls:       []string{"GetDomain"},
+			diagnostics: []string{"Error: Error reading domain"},
+		},
+		{
+			name:     "update tracking settings",
+			existing: true,
+			run: func(test domainTest, prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				plan := prior
+				plan.ClickTrackingEnabled = types.BoolValue(true)
+				return test.update(prior, plan)
+			},
+			calls: []string{"UpdateTracking", "GetDomain", "Check"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !api.Domains[uuid].TrackingClickEnabled || !state.ClickTrackingEnabled.ValueBool() {
+					t.Errorf("expected click tracking to be enabled")
+				}
+			},
+		},
+		{
+			name:     "update rotates DKIM key",
+			existing: true,
+			run: func(test domainTest, prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				plan := prior
+				plan.DkimRotationTrigger = types.StringValue("2026-10-19")
+				return test.update(prior, plan)
+			},
+			calls:       []string{"UpdateTracking", "RotateDkim", "GetDomain", "Check"},
+			diagnostics: []string{"Warning: DKIM rotation in progress"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if recordData(t, state.NextDkimRecord) != uuid+".dkim1.sweego.io." {
+					t.Errorf("unexpected next DKIM record %s", state.NextDkimRecord)
+				}
+			},
+		},
+		{
+			name:     "update completes verified DKIM rotation",
+			existing: true,
+			setup:    rotateDkim(true),
+			run: func(test domainTest, prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.update(prior, prior)
+			},
+			calls: []string{"UpdateTracking", "GetDomain", "CompleteDkimRotation", "GetDomain", "Check"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if !state.NextDkimRecord.IsNull() || recordData(t, state.DkimRecord) != uuid+".dkim1.sweego.io." {
+					t.Errorf("expected the rotation to be completed, got %s, %s", state.DkimRecord, state.NextDkimRecord)
+				}
+			},
+		},
+		{
+			name:     "plan update for verified DKIM rotation",
+			existing: true,
+			setup:    rotateDkim(true),
+			run: func(test domainTest, prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.modifyPlan(prior, prior)
+			},
+			calls: []string{"GetDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, plan *SweegoDomainResourceModel) {
+				if !plan.DkimRecord.IsUnknown() || !plan.NextDkimRecord.IsUnknown() || !plan.ZoneFileSnippet.IsUnknown() {
+					t.Errorf("expected the records to be unknown, got %s, %s", plan.DkimRecord, plan.NextDkimRecord)
+				}
+			},
+		},
+		{
+			name:     "plan without DKIM rotation",
+			existing: true,
+			run: func(test domainTest, prior SweegoDomainResourceModel) (*SweegoDomainResourceModel, diag.Diagnostics) {
+				return test.modifyPlan(prior, prior)
+			},
+			calls: nil,
+			check: func(t *testing.T, api *sweegomock.DomainsApi, plan *SweegoDomainResourceModel) {
+				if plan.DkimRecord.IsUnknown() {
+					t.Errorf("expected the records to be kept")
+				}
+			},
+		},
+		{
+			name:     "delete",
+			existing: true,
+			run:      domainTest.delete,
+			calls:    []string{"DeleteDomain"},
+			check: func(t *testing.T, api *sweegomock.DomainsApi, state *SweegoDomainResourceModel) {
+				if len(api.Domains) != 0 {
+					t.Errorf("expected the domain to be deleted, got %#v", api.Domains)
+				}
+			},
+		},
+		{
+			name:     "delete fails",
+			existing: true,
+			setup: func(t *testing.T, api *sweegomock.DomainsApi, _ *SweegoDomainResourceModel) {
+				api.Errors["DeleteDomain"] = errors.New("internal server error")
+			},
+			run:         domainTest.delete,
+			calls:       []string{"DeleteDomain"},
+			diagnostics: []string{"Error: Error deleting domain"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := sweegomock.NewDomainsApi()
+			domainTest := domainTest{t: t, resource: &SweegoDomainResource{api: api}, schema: domainSchema(t)}
+			if test.listOnly {
+				domainTest.resource.domainList = newDomainListCache()
+			}
+
+			var prior SweegoDomainResourceModel
+			if test.existing {
+				state, diagnostics := domainTest.create(plannedDomain("example.com"))
+				if diagnostics.HasError() || state == nil {
+					t.Fatalf("cannot create domain: %v", diagnostics)
+				}
+				prior = *state
+			}
+			if test.setup != nil {
+				test.setup(t, api, &prior)
+			}
+			api.ResetCalls()
+
+			state, diagnostics := test.run(domainTest, prior)
+
+			calls := []string{}
+			for _, call := range api.ResetCalls() {
+				calls = append(calls, call.Method)
+			}
+			if !reflect.DeepEqual(calls, append([]string{}, test.calls...)) {
+				t.Errorf("expected calls %v, got %v", test.calls, calls)
+			}
+			summaries := []string{}
+			for _, diagnostic := range diagnostics {
+				summaries = append(summaries, diagnostic.Severity().String()+": "+diagnostic.Summary())
+			}
+			if !reflect.DeepEqual(summaries, append([]string{}, test.diagnostics...)) {
+				t.Errorf("expected diagnostics %v, got %v", test.diagnostics, diagnostics)
+			}
+			if test.check != nil {
+				test.check(t, api, state)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ action.Action = &SweegoDomainRotateDkimAction{}
+var _ action.ActionWithConfigure = &SweegoDomainRotateDkimAction{}
+
+func NewSweegoDomainRotateDkimAction() action.Action {
+	return &SweegoDomainRotateDkimAction{}
+}
+
+// SweegoDomainRotateDkimAction defines the action implementation.
+type SweegoDomainRotateDkimAction struct {
+	api sweego.SweegoDomainsApi
+}
+
+// SweegoDomainRotateDkimActionModel describes the action data model.
+type SweegoDomainRotateDkimActionModel struct {
+	ClientId types.String `tfsdk:"client_id"`
+	Uuid     types.String `tfsdk:"uuid"`
+}
+
+func (a *SweegoDomainRotateDkimAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_rotate_dkim"
+}
+
+func (a *SweegoDomainRotateDkimAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a DKIM key rotation of a domain. The new DKIM record is available as `next_dkim_record` of the `sweego_domain` after the next refresh and needs to be published. Once sweego has verified the new record, the next apply of the `sweego_domain` completes the rotation.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideActionAttribute,
+			"uuid": schema.StringAttribute{
+				Description: "UUID of the sweego domain (e.g. sweego_domain.my_domain.uuid)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *SweegoDomainRotateDkimAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.api = providerData.Api
+}
+
+func (a *SweegoDomainRotateDkimAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SweegoDomainRotateDkimActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, err := withLogger(ctx, a.api).RotateDkim(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error rotating DKIM key", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("New DKIM record that needs to be published: %s %s %s", record.Name, record.Type, record.Data),
+	})
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
+)
+
+func TestDomainRotateDkimAction(t *testing.T) {
+	api := sweegomock.NewDomainsApi()
+	if _, err := api.CreateDomain("", "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	uuid := sweegomock.DomainUuid(1)
+
+	messages, diagnostics := invokeAction(t, &SweegoDomainRotateDkimAction{api: api}, map[string]tftypes.Value{
+		"uuid": tftypes.NewValue(tftypes.String, uuid),
+	})
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diagnostics)
+	}
+	next := api.Domains[uuid].NextDkimRecord
+	expected := []string{"New DKIM record that needs to be published: " + next.Name + " " + next.Type + " " + next.Data}
+	if next.Name == "" || !reflect.DeepEqual(messages, expected) {
+		t.Errorf("expected messages %#v, got %#v", expected, messages)
+	}
+
+	_, diagnostics = invokeAction(t, &SweegoDomainRotateDkimAction{api: api}, map[string]tftypes.Value{
+		"uuid": tftypes.NewValue(tftypes.String, "unknown"),
+	})
+	if summaries := diagnosticSummaries(diagnostics); !reflect.DeepEqual(summaries, []string{"Error: Error rotating DKIM key"}) {
+		t.Errorf("expected an error rotating the DKIM key of an unknown domain, got %v", diagnostics)
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ resource.Resource = &SweegoIpPoolResource{}
+var _ resource.ResourceWithImportState = &SweegoIpPoolResource{}
+
+func NewSweegoIpPoolResource() resource.Resource {
+	return &SweegoIpPoolResource{}
+}
+
+// SweegoIpPoolResource defines the resource implementation.
+type SweegoIpPoolResource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoIpPoolResourceModel describes the resource data model.
+type SweegoIpPoolResourceModel struct {
+	ClientId types.String `tfsdk:"client_id"`
+	Uuid     types.String `tfsdk:"uuid"`
+	Name     types.String `tfsdk:"name"`
+	Ips      types.Set    `tfsdk:"ips"`
+}
+
+func (r *SweegoIpPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_pool"
+}
+
+func (r *SweegoIpPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Pool of dedicated IPs. Domains can be assigned to a pool using `sweego_domain_ip_pool_assignment` in order to separate the reputation of e.g. marketing and transactional traffic.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideResourceAttribute,
+			"uuid": schema.StringAttribute{
+				Description: "UUID of the IP pool in sweego's system.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the IP pool (e.g. transactional)",
+				Required:    true,
+			},
+			"ips": schema.SetAttribute{
+				Description: "Dedicated IPs that are part of the pool. Available IPs can be listed using the `sweego_dedicated_ips` data source.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *SweegoIpPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = providerData.Api
+}
+
+func (r *SweegoIpPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweegoIpPoolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := ipPoolChangeRequest(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdPool, err := withLogger(ctx, r.api).CreateIpPool(data.ClientId.ValueString(), pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating IP pool", err.Error())
+		return
+	}
+
+	data = fillIpPoolStateFromResponse(ctx, createdPool, data, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoIpPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweegoIpPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := withLogger(ctx, r.api).GetIpPool(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if sweego.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading IP pool", fmt.Sprintf("Error reading IP pool: %s", err.Error()))
+		return
+	}
+
+	data = fillIpPoolStateFromResponse(ctx, pool, data, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoIpPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweegoIpPoolResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := ipPoolChangeRequest(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedPool, err := withLogger(ctx, r.api).UpdateIpPool(data.ClientId.ValueString(), data.Uuid.ValueString(), pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating IP pool", err.Error())
+		return
+	}
+
+	data = fillIpPoolStateFromResponse(ctx, updatedPool, data, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoIpPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweegoIpPoolResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).DeleteIpPool(data.ClientId.ValueString(), data.Uuid.ValueString())
+	if err != nil && !sweego.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting IP pool", fmt.Sprintf("Error deleting IP pool: %s", err.Error()))
+	}
+}
+
+func (r *SweegoIpPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clientId, ids, err := parseImportId(req.ID, "uuid", 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	if clientId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), ids[0])...)
+}
+
+func ipPoolChangeRequest(ctx context.Context, data SweegoIpPoolResourceModel, diagnostics *diag.Diagnostics) sweego.SweegoIpPoolChangeRequest {
+	ips := []string{}
+	diagnostics.Append(data.Ips.ElementsAs(ctx, &ips, false)...)
+
+	return sweego.SweegoIpPoolChangeRequest{
+		Name:   data.Name.ValueString(),
+		IpList: ips,
+	}
+}
+
+func fillIpPoolStateFromResponse(ctx context.Context, response sweego.SweegoIpPool, state SweegoIpPoolResourceModel, diagnostics *diag.Diagnostics) SweegoIpPoolResourceModel {
+	if response.Uuid != "" {
+		state.Uuid = types.StringValue(response.Uuid)
+	}
+	state.Name = types.StringValue(response.Name)
+
+	// A pool without IPs is returned with a null ip_list, which must not turn the configured empty set into null
+	ipList := append([]string{}, response.IpList...)
+	ips, d := types.SetValueFrom(ctx, types.StringType, ipList)
+	diagnostics.Append(d...)
+	state.Ips = ips
+
+	return state
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+func TestFillIpPoolStateFromResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		ipList   []string
+		expected int
+	}{
+		{"ips", []string{"192.0.2.1", "192.0.2.2"}, 2},
+		{"empty ip_list", []string{}, 0},
+		{"null ip_list", nil, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var diagnostics diag.Diagnostics
+			state := fillIpPoolStateFromResponse(context.Background(), sweego.SweegoIpPool{Uuid: "uuid", Name: "pool", IpList: test.ipList}, SweegoIpPoolResourceModel{}, &diagnostics)
+			if diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics %v", diagnostics)
+			}
+			if state.Ips.IsNull() || len(state.Ips.Elements()) != test.expected {
+				t.Errorf("expected %d ips, got %s", test.expected, state.Ips)
+			}
+			if !state.Uuid.Equal(types.StringValue("uuid")) {
+				t.Errorf("unexpected uuid %s", state.Uuid)
+			}
+		})
+	}
+}
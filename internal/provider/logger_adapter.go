@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
 )
 
 type LoggerAdapter struct {
@@ -23,3 +24,13 @@ func (logger *LoggerAdapter) Error(message string) {
 func (logger *LoggerAdapter) Debug(message string) {
 	tflog.Debug(logger.context, message)
 }
+
+// withLogger returns a copy of the API that logs to terraform and whose requests are cancelled with the
+// context (e.g. if terraform is interrupted). Other implementations of the API interfaces (e.g. mocks) are
+// returned as they are.
+func withLogger[T any](ctx context.Context, api T) T {
+	if sweegoApi, ok := any(api).(*sweego.SweegoApi); ok {
+		return any(sweegoApi.WithLogger(NewLoggerAdapter(ctx)).WithContext(ctx)).(T)
+	}
+	return api
+}
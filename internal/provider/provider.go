@@ -1,18 +1,27 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/j6s/terraform-provider-sweego-provider/internal/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/internal/dnscheck"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
 )
 
 var _ provider.Provider = &SweegoProvider{}
@@ -30,9 +39,33 @@ type SweegoProvider struct {
 
 // SweegoProviderModel describes the provider data model.
 type SweegoProviderModel struct {
-	BaseUrl  types.String `tfsdk:"base_url"`
-	ApiKey   types.String `tfsdk:"api_key"`
-	ClientId types.String `tfsdk:"client_id"`
+	BaseUrl            types.String             `tfsdk:"base_url"`
+	ApiKey             types.String             `tfsdk:"api_key"`
+	ClientId           types.String             `tfsdk:"client_id"`
+	RequestTimeout     types.String             `tfsdk:"request_timeout"`
+	ProxyUrl           types.String             `tfsdk:"proxy_url"`
+	CaCertPem          types.String             `tfsdk:"ca_cert_pem"`
+	CaCertFile         types.String             `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool               `tfsdk:"insecure_skip_verify"`
+	RequestsPerSecond  types.Float64            `tfsdk:"requests_per_second"`
+	RefreshMode        types.String             `tfsdk:"refresh_mode"`
+	Mock               types.Bool               `tfsdk:"mock"`
+	DnsPreflight       *SweegoDnsPreflightModel `tfsdk:"dns_preflight"`
+}
+
+type SweegoDnsPreflightModel struct {
+	Nameservers   []types.String `tfsdk:"nameservers"`
+	Authoritative types.Bool     `tfsdk:"authoritative"`
+	Timeout       types.String   `tfsdk:"timeout"`
+}
+
+// SweegoProviderData is passed to all resources, data sources and actions.
+type SweegoProviderData struct {
+	Api *sweego.SweegoApi
+	// DnsPreflight is nil, if the DNS pre-flight check is not enabled.
+	DnsPreflight *dnscheck.Checker
+	// DomainList is nil, if refresh_mode is not list_only.
+	DomainList *domainListCache
 }
 
 func (p *SweegoProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,21 +77,70 @@ func (p *SweegoProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("Base URL of the sweego API. Defaults to %s", sweego.DefaultBaseUrl),
+				MarkdownDescription: fmt.Sprintf("Base URL of the sweego API. Defaults to the `%s` environment variable, `base_url` in the credentials file or %s", sweego.EnvBaseUrl, sweego.DefaultBaseUrl),
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key used to authenticate the sweego API",
-				Optional:            false,
-				Required:            true,
+				MarkdownDescription: fmt.Sprintf("API key used to authenticate the sweego API. Defaults to the `%s` environment variable or `api_key` in the credentials file.", sweego.EnvApiKey),
+				Optional:            true,
 				Sensitive:           true,
 			},
 			"client_id": schema.StringAttribute{
-				MarkdownDescription: "Client ID used to authenticate the sweego API",
-				Optional:            false,
-				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Client ID used to authenticate the sweego API. Defaults to the `%s` environment variable or `client_id` in the credentials file.", sweego.EnvClientId),
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Timeout of a single API request as Go duration string (e.g. `30s`). Defaults to %s", defaultRequestTimeout),
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy API requests are sent through (e.g. `http://proxy.internal:3128`). Defaults to the proxy configured using the `HTTPS_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate(s) that are trusted in addition to the system certificates, e.g. for TLS intercepting proxies",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificate(s). Alternative to `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disables verification of the TLS certificate of the API. Only use this for local stand-ins of the API.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of API requests per second, shared by all resources. `0` disables the limit. Defaults to %g. Requests are slowed down further if the API reports a low remaining rate limit quota.", defaultRequestsPerSecond),
+				Optional:            true,
+			},
+			"refresh_mode": schema.StringAttribute{
+				MarkdownDescription: "How `sweego_domain` resources are refreshed. `full` (default) reads each domain. `list_only` lists the domains of each client once and only reads domains that are not verified (or have a DKIM rotation in progress), keeping the records of verified domains from the state. Neither mode lets sweego verify the records, see `recheck_on_refresh` of `sweego_domain`.",
+				Optional:            true,
+			},
+			"mock": schema.BoolAttribute{
+				MarkdownDescription: "Answers all requests by an in-process fake of the API instead of sweego (defaults to false), so modules can be planned and tested without a sweego account. Domains are created with deterministic UUIDs and records and are verified by the first check. Only domains are supported. The fake keeps its state in memory, domains created by an apply are unknown to the next run - unless the `SWEEGO_MOCK_STATE_FILE` environment variable names a file the state is kept in.",
+				Optional:            true,
+			},
+			"dns_preflight": schema.SingleNestedAttribute{
+				MarkdownDescription: "If set, the DNS records required by `sweego_domain` resources are resolved against the given nameservers and problems (e.g. wrong CNAME targets, missing trailing dots or duplicate SPF records) are reported as warnings. This helps to detect split-horizon DNS or propagation problems before sweego tries to verify the domain.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"nameservers": schema.ListAttribute{
+						MarkdownDescription: "Nameservers to query in the form `host` or `host:port` (e.g. `1.1.1.1` or `[2606:4700:4700::1111]:53`)",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"authoritative": schema.BoolAttribute{
+						MarkdownDescription: "Whether the authoritative nameservers of the domain should be queried as well (defaults to false)",
+						Optional:            true,
+					},
+					"timeout": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Timeout of a single DNS query as Go duration string (e.g. `2s`). Defaults to %s", dnscheck.DefaultTimeout),
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -72,19 +154,105 @@ func (p *SweegoProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	baseUrl := data.BaseUrl.ValueString()
-	if baseUrl == "" {
-		baseUrl = sweego.DefaultBaseUrl
+	credentials, err := sweego.LoadCredentials(sweego.SweegoCredentials{
+		ApiKey:   data.ApiKey.ValueString(),
+		ClientId: data.ClientId.ValueString(),
+		BaseUrl:  data.BaseUrl.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot read credentials file", err.Error())
+	}
+	mock := data.Mock.ValueBool()
+	if mock {
+		credentials.ApiKey = cmp.Or(credentials.ApiKey, "mock")
+		credentials.ClientId = cmp.Or(credentials.ClientId, "mock")
+		resp.Diagnostics.AddWarning("Mock mode", "The provider does not connect to sweego, all changes are applied to an in-process fake of the API")
+	}
+	if credentials.ApiKey == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("api_key"), "Missing API key", fmt.Sprintf("Set api_key, the %s environment variable or api_key in %s", sweego.EnvApiKey, sweego.CredentialsFile()))
+	}
+	if credentials.ClientId == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_id"), "Missing client ID", fmt.Sprintf("Set client_id, the %s environment variable or client_id in %s", sweego.EnvClientId, sweego.CredentialsFile()))
 	}
-	client := sweego.NewSweegoApiWithBaseUrl(baseUrl, data.ApiKey.ValueString(), data.ClientId.ValueString())
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if !data.CaCertPem.IsNull() && !data.CaCertFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Conflicting configuration", "Only one of ca_cert_pem and ca_cert_file can be set")
+	}
+	requestsPerSecond := defaultRequestsPerSecond
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+	if requestsPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "Invalid rate limit", "requests_per_second must not be negative")
+	}
+	if !data.RefreshMode.IsNull() && !slices.Contains(refreshModes, data.RefreshMode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("refresh_mode"), "Invalid refresh mode", fmt.Sprintf("refresh_mode must be one of %s", strings.Join(refreshModes, ", ")))
+	}
+	httpClient := newHttpClient(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if mock {
+		transport := sweegomock.NewTransport(sweegomock.NewDomainsApi())
+		if stateFile := os.Getenv(sweegomock.EnvStateFile); stateFile != "" {
+			if err := transport.Domains.Load(stateFile); err != nil {
+				resp.Diagnostics.AddError("Cannot read mock state", fmt.Sprintf("Cannot read %s (%s): %s", stateFile, sweegomock.EnvStateFile, err))
+				return
+			}
+			transport.StateFile = stateFile
+		}
+		httpClient = &http.Client{Transport: transport}
+		requestsPerSecond = 0
+	}
+
+	options := []sweego.SweegoApiOption{
+		sweego.WithHttpClient(httpClient),
+		sweego.WithUserAgent(userAgent(p.version, req.TerraformVersion)),
+		sweego.WithRetry(3, time.Second),
+		sweego.WithRateLimit(requestsPerSecond, max(int(requestsPerSecond), 1)),
+	}
+	if credentials.BaseUrl != "" {
+		options = append(options, sweego.WithBaseUrl(credentials.BaseUrl))
+	}
+	providerData := &SweegoProviderData{
+		Api: sweego.NewSweegoApi(credentials.ApiKey, credentials.ClientId, options...),
+	}
+
+	if data.DnsPreflight != nil {
+		checker := &dnscheck.Checker{
+			Authoritative: data.DnsPreflight.Authoritative.ValueBool(),
+		}
+		for _, nameserver := range data.DnsPreflight.Nameservers {
+			checker.Nameservers = append(checker.Nameservers, nameserver.ValueString())
+		}
+		if !data.DnsPreflight.Timeout.IsNull() {
+			timeout, err := time.ParseDuration(data.DnsPreflight.Timeout.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("dns_preflight").AtName("timeout"), "Invalid timeout", err.Error())
+				return
+			}
+			checker.Timeout = timeout
+		}
+		providerData.DnsPreflight = checker
+	}
+
+	if data.RefreshMode.ValueString() == refreshModeListOnly {
+		providerData.DomainList = newDomainListCache()
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.ActionData = providerData
 }
 
 func (p *SweegoProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSweegoDomainResource,
+		NewSweegoSuppressionResource,
+		NewSweegoSenderResource,
+		NewSweegoClientResource,
+		NewSweegoIpPoolResource,
+		NewSweegoDomainIpPoolAssignmentResource,
 	}
 }
 
@@ -93,15 +261,27 @@ func (p *SweegoProvider) EphemeralResources(ctx context.Context) []func() epheme
 }
 
 func (p *SweegoProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewSweegoSuppressionsDataSource,
+		NewSweegoDedicatedIpsDataSource,
+	}
 }
 
 func (p *SweegoProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewRecordFqdnFunction,
+		NewRecordsForFunction,
+		NewRenderRecordsFunction,
+		NewStripTrailingDotFunction,
+	}
 }
 
 func (p *SweegoProvider) Actions(ctx context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewSweegoDomainCheckAction,
+		NewSweegoDomainRotateDkimAction,
+		NewSweegoSendTestEmailAction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultRequestTimeout is used if request_timeout is not configured.
+const defaultRequestTimeout = 60 * time.Second
+
+// defaultRequestsPerSecond is used if requests_per_second is not configured. Terraform runs 10 operations
+// in parallel by default, each of them sending multiple requests.
+const defaultRequestsPerSecond = 5.0
+
+// userAgent identifies the provider and terraform version, e.g. for egress proxies.
+func userAgent(providerVersion string, terraformVersion string) string {
+	if terraformVersion == "" {
+		terraformVersion = "unknown"
+	}
+	return fmt.Sprintf("terraform-provider-sweego/%s terraform/%s", providerVersion, terraformVersion)
+}
+
+// newHttpClient creates the HTTP client used for all API requests according to the transport
+// configuration of the provider.
+func newHttpClient(data SweegoProviderModel, diagnostics *diag.Diagnostics) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   defaultRequestTimeout,
+	}
+
+	if !data.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid timeout", err.Error())
+		} else {
+			client.Timeout = timeout
+		}
+	}
+
+	if !data.ProxyUrl.IsNull() {
+		proxyUrl, err := url.Parse(data.ProxyUrl.ValueString())
+		if err != nil || proxyUrl.Host == "" {
+			diagnostics.AddAttributeError(path.Root("proxy_url"), "Invalid proxy URL", fmt.Sprintf("%#v is not a valid proxy URL (e.g. http://proxy.internal:3128)", data.ProxyUrl.ValueString()))
+		} else {
+			transport.Proxy = http.ProxyURL(proxyUrl)
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	caCertPem := []byte(data.CaCertPem.ValueString())
+	attributePath := path.Root("ca_cert_pem")
+	if !data.CaCertFile.IsNull() {
+		var err error
+		attributePath = path.Root("ca_cert_file")
+		caCertPem, err = os.ReadFile(data.CaCertFile.ValueString())
+		if err != nil {
+			diagnostics.AddAttributeError(attributePath, "Cannot read CA certificate", err.Error())
+		}
+	}
+	if len(caCertPem) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCertPem) {
+			diagnostics.AddAttributeError(attributePath, "Invalid CA certificate", "No PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return client
+}
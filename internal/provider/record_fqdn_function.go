@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ function.Function = &RecordFqdnFunction{}
+
+func NewRecordFqdnFunction() function.Function {
+	return &RecordFqdnFunction{}
+}
+
+// RecordFqdnFunction defines the function implementation.
+type RecordFqdnFunction struct{}
+
+func (f *RecordFqdnFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "record_fqdn"
+}
+
+func (f *RecordFqdnFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the fully qualified name of a DNS record of a sweego_domain",
+		Description: "Returns the fully qualified name (without trailing dot) of a DNS record returned by `sweego_domain` (e.g. `dkim_record`), as the `name` of the record does not include the domain.",
+
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "record",
+				Description:    "DNS record of a sweego_domain, e.g. `sweego_domain.my_domain.dkim_record`",
+				AttributeTypes: dnsRecordAttributeTypes(),
+			},
+			function.StringParameter{
+				Name:        "domain",
+				Description: "Domain the record belongs to, e.g. `sweego_domain.my_domain.domain`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RecordFqdnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record types.Object
+	var domain string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &record, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	var model dnsRecordModel
+	resp.Error = function.FuncErrorFromDiags(ctx, record.As(ctx, &model, basetypes.ObjectAsOptions{}))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sweego.RecordFqdn(model.Name.ValueString(), domain)))
+}
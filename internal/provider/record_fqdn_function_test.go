@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+func TestRecordFqdnFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		domain   string
+		expected string
+	}{
+		{"@", "example.com", "example.com"},
+		{"swg._domainkey", "example.com", "swg._domainkey.example.com"},
+		{"swg", "mail.example.com.", "swg.mail.example.com"},
+		{"swg.example.com.", "example.com", "swg.example.com"},
+		{"swg.mail.example.com", "mail.example.com", "swg.mail.example.com"},
+	}
+	for _, test := range tests {
+		record := recordToObject(sweego.SweegoDomainRecord{Name: test.name, Type: "CNAME", Data: "uuid.dkim.sweego.io."})
+		result, err := runFunction(t, NewRecordFqdnFunction(), record, types.StringValue(test.domain))
+		if err != nil {
+			t.Fatalf("record_fqdn(%#v, %#v) failed: %s", test.name, test.domain, err)
+		}
+		if !result.Equal(types.StringValue(test.expected)) {
+			t.Errorf("record_fqdn(%#v, %#v) = %s, expected %#v", test.name, test.domain, result, test.expected)
+		}
+	}
+}
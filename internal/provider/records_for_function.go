@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &RecordsForFunction{}
+
+func NewRecordsForFunction() function.Function {
+	return &RecordsForFunction{}
+}
+
+// RecordsForFunction defines the function implementation.
+type RecordsForFunction struct{}
+
+var requiredRecordAttributeTypes = map[string]attr.Type{
+	"purpose": types.StringType,
+	"name":    types.StringType,
+	"fqdn":    types.StringType,
+	"type":    types.StringType,
+	"data":    types.StringType,
+}
+
+func (f *RecordsForFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "records_for"
+}
+
+func (f *RecordsForFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns all DNS records required by a sweego_domain",
+		MarkdownDescription: "Returns all DNS records that need to be published for a `sweego_domain` as a map keyed by the purpose of the record " +
+			"(`domain`, `dkim`, `dkim_next`, `dmarc`, `tracking`, `inbound_0`, `inbound_1`, ...), so it can be used with `for_each` directly. " +
+			"Each record contains `purpose`, `name` (relative to the domain), `fqdn`, `type` and `data`. " +
+			"Neither `fqdn` nor `data` contain a trailing dot. Records that are not provided by sweego are omitted.\n\n" +
+			"The keys depend on the records sweego provides, so they are unknown until the domain exists. If the domain " +
+			"is created in the same apply, `for_each` fails with \"Invalid for_each argument\": Create the domain first " +
+			"(`terraform apply -target=sweego_domain.my_domain`), or iterate over the purposes that are always provided " +
+			"(`toset([\"domain\", \"dkim\", \"dmarc\", \"tracking\"])`) and look the record up by `each.key`.",
+
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "domain",
+				Description:    "The sweego_domain resource, e.g. `sweego_domain.my_domain`",
+				AttributeTypes: domainRecordsAttributeTypes(),
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{AttrTypes: requiredRecordAttributeTypes},
+		},
+	}
+}
+
+func (f *RecordsForFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var domain types.Object
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	details, diagnostics := domainDetailsFromObject(ctx, domain)
+	resp.Error = function.FuncErrorFromDiags(ctx, diagnostics)
+	if resp.Error != nil {
+		return
+	}
+
+	records := map[string]attr.Value{}
+	for _, record := range details.RequiredRecords() {
+		records[record.Purpose] = types.ObjectValueMust(requiredRecordAttributeTypes, map[string]attr.Value{
+			"purpose": types.StringValue(record.Purpose),
+			"name":    types.StringValue(record.Name),
+			"fqdn":    types.StringValue(record.Fqdn),
+			"type":    types.StringValue(record.Type),
+			"data":    types.StringValue(record.Data),
+		})
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.MapValueMust(types.ObjectType{AttrTypes: requiredRecordAttributeTypes}, records)))
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRecordsForFunction(t *testing.T) {
+	result, err := runFunction(t, NewRecordsForFunction(), domainRecordsObject(functionTestDomain()))
+	if err != nil {
+		t.Fatalf("records_for failed: %s", err)
+	}
+
+	type record struct {
+		Purpose string `tfsdk:"purpose"`
+		Name    string `tfsdk:"name"`
+		Fqdn    string `tfsdk:"fqdn"`
+		Type    string `tfsdk:"type"`
+		Data    string `tfsdk:"data"`
+	}
+	records := map[string]record{}
+	if diagnostics := result.(types.Map).ElementsAs(context.Background(), &records, false); diagnostics.HasError() {
+		t.Fatalf("unexpected result %s: %v", result, diagnostics)
+	}
+
+	expected := map[string]record{
+		"domain":    {Purpose: "domain", Name: "swg", Fqdn: "swg.example.com", Type: "CNAME", Data: "uuid.domains.sweego.io"},
+		"dkim":      {Purpose: "dkim", Name: "swg._domainkey", Fqdn: "swg._domainkey.example.com", Type: "CNAME", Data: "uuid.dkim.sweego.io"},
+		"dmarc":     {Purpose: "dmarc", Name: "_dmarc", Fqdn: "_dmarc.example.com", Type: "TXT", Data: "v=DMARC1; p=none"},
+		"inbound_0": {Purpose: "inbound_0", Name: "", Fqdn: "example.com", Type: "MX", Data: "10 mx.sweego.io"},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("records_for() = %#v, expected %#v", records, expected)
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ function.Function = &RenderRecordsFunction{}
+
+func NewRenderRecordsFunction() function.Function {
+	return &RenderRecordsFunction{}
+}
+
+// RenderRecordsFunction defines the function implementation.
+type RenderRecordsFunction struct{}
+
+func (f *RenderRecordsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_records"
+}
+
+func (f *RenderRecordsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders the DNS records required by a sweego_domain for DNS-as-code tools",
+		MarkdownDescription: "Renders all DNS records that need to be published for a `sweego_domain` in a format that can be used by DNS setups not managed by a terraform provider. Supported formats: " +
+			"`bind` (zone file snippet), `octodns_yaml` (OctoDNS zone config), `dnscontrol_js` (DNSControl `D_EXTEND` block) and `json`.",
+
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "domain",
+				Description:    "The sweego_domain resource, e.g. `sweego_domain.my_domain`",
+				AttributeTypes: domainRecordsAttributeTypes(),
+			},
+			function.StringParameter{
+				Name:        "format",
+				Description: fmt.Sprintf("Output format, one of: %s", strings.Join(sweego.RecordFormats, ", ")),
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RenderRecordsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var domain types.Object
+	var format string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &domain, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	details, diagnostics := domainDetailsFromObject(ctx, domain)
+	resp.Error = function.FuncErrorFromDiags(ctx, diagnostics)
+	if resp.Error != nil {
+		return
+	}
+
+	rendered, err := details.RenderRecords(format)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, rendered))
+}
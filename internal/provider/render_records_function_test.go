@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// runFunction runs the provider function with the given arguments the same way the framework does.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definition := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	if definition.Diagnostics.HasError() {
+		t.Fatalf("invalid definition: %v", definition.Diagnostics)
+	}
+
+	response := function.RunResponse{
+		Result: function.NewResultData(definition.Definition.Return.GetType().ValueType(ctx)),
+	}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, &response)
+
+	return response.Result.Value(), response.Error
+}
+
+// domainRecordsObject converts the domain to the object passed to functions, as if a sweego_domain had been passed.
+func domainRecordsObject(details sweego.SweegoDomainDetails) types.Object {
+	recordType := types.ObjectType{AttrTypes: dnsRecordAttributeTypes()}
+
+	inbound := []attr.Value{}
+	for _, record := range details.InboundRecordList {
+		inbound = append(inbound, recordToObject(record))
+	}
+
+	return types.ObjectValueMust(domainRecordsAttributeTypes(), map[string]attr.Value{
+		"domain":              types.StringValue(details.Domain),
+		"domain_record":       recordToObject(details.DomainRecord),
+		"dkim_record":         recordToObject(details.DkimRecord),
+		"next_dkim_record":    types.ObjectNull(dnsRecordAttributeTypes()),
+		"dmarc_record":        recordToObject(details.DmarcRecord),
+		"tracking_record":     recordToObject(details.TrackingRecord),
+		"inbound_record_list": types.ListValueMust(recordType, inbound),
+	})
+}
+
+func functionTestDomain() sweego.SweegoDomainDetails {
+	return sweego.SweegoDomainDetails{
+		Domain:         "example.com",
+		DomainRecord:   sweego.SweegoDomainRecord{Name: "swg", Type: "CNAME", Data: "uuid.domains.sweego.io."},
+		DkimRecord:     sweego.SweegoDomainRecord{Name: "swg._domainkey.example.com.", Type: "CNAME", Data: "uuid.dkim.sweego.io."},
+		DmarcRecord:    sweego.SweegoDomainRecord{Name: "_dmarc", Type: "TXT", Data: "v=DMARC1; p=none"},
+		TrackingRecord: sweego.SweegoDomainRecord{Name: "", Type: "", Data: ""},
+		InboundRecordList: []sweego.SweegoDomainRecord{
+			{Name: "@", Type: "MX", Data: "10 mx.sweego.io."},
+		},
+	}
+}
+
+func TestRenderRecordsFunction(t *testing.T) {
+	details := functionTestDomain()
+
+	for _, format := range sweego.RecordFormats {
+		result, err := runFunction(t, NewRenderRecordsFunction(), domainRecordsObject(details), types.StringValue(format))
+		if err != nil {
+			t.Fatalf("render_records(%s) failed: %s", format, err)
+		}
+
+		expected, _ := details.RenderRecords(format)
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("render_records(%s) = %s, expected %#v", format, result, expected)
+		}
+		if strings.Contains(expected, "tracking") || !strings.Contains(expected, "mx.sweego.io") {
+			t.Errorf("render_records(%s) = %s does not contain the expected records", format, result)
+		}
+	}
+}
+
+func TestRenderRecordsFunctionUnknownFormat(t *testing.T) {
+	_, err := runFunction(t, NewRenderRecordsFunction(), domainRecordsObject(functionTestDomain()), types.StringValue("yaml"))
+	if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 1 {
+		t.Errorf("expected an error for the format argument, got %v", err)
+	}
+}
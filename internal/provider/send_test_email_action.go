@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ action.Action = &SweegoSendTestEmailAction{}
+var _ action.ActionWithConfigure = &SweegoSendTestEmailAction{}
+var _ action.ActionWithValidateConfig = &SweegoSendTestEmailAction{}
+
+const defaultTestEmailSubject = "sweego test E-Mail"
+
+func NewSweegoSendTestEmailAction() action.Action {
+	return &SweegoSendTestEmailAction{}
+}
+
+// sweegoSendTestEmailApi is the part of the API the action uses: The domain of the From address is looked up
+// before sending.
+type sweegoSendTestEmailApi interface {
+	sweego.SweegoDomainsApi
+	sweego.SweegoSendApi
+}
+
+// SweegoSendTestEmailAction defines the action implementation.
+type SweegoSendTestEmailAction struct {
+	api sweegoSendTestEmailApi
+}
+
+// SweegoSendTestEmailActionModel describes the action data model.
+type SweegoSendTestEmailActionModel struct {
+	ClientId types.String `tfsdk:"client_id"`
+	From     types.String `tfsdk:"from"`
+	To       types.String `tfsdk:"to"`
+	Subject  types.String `tfsdk:"subject"`
+}
+
+func (a *SweegoSendTestEmailAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_send_test_email"
+}
+
+func (a *SweegoSendTestEmailAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a short plain text test E-Mail through a verified sweego domain, e.g. in order to check delivery after changing DNS records.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideActionAttribute,
+			"from": schema.StringAttribute{
+				Description: "From address of the E-Mail (e.g. noreply@my-domain.eu, without display name). Its domain determines the sweego domain the E-Mail is sent through, which must be a verified domain of the client.",
+				Required:    true,
+			},
+			"to": schema.StringAttribute{
+				Description: "Address the test E-Mail is sent to (without display name)",
+				Required:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: fmt.Sprintf("Subject of the test E-Mail (defaults to %#v)", defaultTestEmailSubject),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *SweegoSendTestEmailAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.api = providerData.Api
+}
+
+func (a *SweegoSendTestEmailAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data SweegoSendTestEmailActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attribute, value := range map[string]types.String{"from": data.From, "to": data.To} {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if err := validateBareAddress(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid E-Mail address", fmt.Sprintf("%#v is not a valid E-Mail address: %s", value.ValueString(), err.Error()))
+		}
+	}
+}
+
+func (a *SweegoSendTestEmailAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SweegoSendTestEmailActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subject := defaultTestEmailSubject
+	if !data.Subject.IsNull() {
+		subject = data.Subject.ValueString()
+	}
+
+	api := withLogger(ctx, a.api)
+	if !validateTestEmailDomain(api, data.ClientId.ValueString(), data.From.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	response, err := api.Send(sweego.SweegoSendRequest{
+		Recipients: []sweego.SweegoAddress{{Email: data.To.ValueString()}},
+		From:       sweego.SweegoAddress{Email: data.From.ValueString()},
+		Subject:    subject,
+		MessageTxt: fmt.Sprintf("This is a test E-Mail sent from %s through sweego by terraform.", data.From.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error sending test E-Mail", err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sent test E-Mail to %s (transaction %s, message ID %s)", data.To.ValueString(), response.TransactionId, response.MessageIds[data.To.ValueString()]),
+	})
+}
+
+// validateTestEmailDomain checks that the domain of the From address is a verified domain of the client, as
+// the API only reports that sending failed otherwise.
+func validateTestEmailDomain(api sweego.SweegoDomainsApi, clientId string, from string, diagnostics *diag.Diagnostics) bool {
+	fromDomain := from[strings.LastIndex(from, "@")+1:]
+
+	domains, err := api.ListDomains(clientId)
+	if err != nil {
+		diagnostics.AddError("Error listing domains", fmt.Sprintf("Error listing domains: %s", err.Error()))
+		return false
+	}
+	for _, domain := range domains {
+		if !strings.EqualFold(domain.Domain, fromDomain) {
+			continue
+		}
+		if !domain.IsVerified {
+			diagnostics.AddAttributeError(path.Root("from"), "Domain not verified", fmt.Sprintf("The domain %s of the From address is not verified by sweego yet", domain.Domain))
+			return false
+		}
+		return true
+	}
+
+	diagnostics.AddAttributeError(path.Root("from"), "Unknown domain", fmt.Sprintf("The domain %s of the From address is not a sweego domain of the client", fromDomain))
+	return false
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego/sweegomock"
+)
+
+// sendApi records sent E-Mails in addition to the domains of the mock.
+type sendApi struct {
+	*sweegomock.DomainsApi
+	sent []sweego.SweegoSendRequest
+}
+
+func (api *sendApi) Send(message sweego.SweegoSendRequest) (sweego.SweegoSendResponse, error) {
+	api.sent = append(api.sent, message)
+	return sweego.SweegoSendResponse{
+		TransactionId: "transaction",
+		MessageIds:    map[string]string{message.Recipients[0].Email: "message"},
+	}, nil
+}
+
+func TestSendTestEmailAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]tftypes.Value
+		// verified is the verification status of the domain example.com
+		verified    bool
+		sent        bool
+		messages    []string
+		diagnostics []string
+	}{
+		{
+			name: "send",
+			values: map[string]tftypes.Value{
+				"from": tftypes.NewValue(tftypes.String, "noreply@example.com"),
+				"to":   tftypes.NewValue(tftypes.String, "jane@example.org"),
+			},
+			verified: true,
+			sent:     true,
+			messages: []string{"Sent test E-Mail to jane@example.org (transaction transaction, message ID message)"},
+		},
+		{
+			name: "display name",
+			values: map[string]tftypes.Value{
+				"from": tftypes.NewValue(tftypes.String, "noreply@example.com"),
+				"to":   tftypes.NewValue(tftypes.String, "Jane <jane@example.org>"),
+			},
+			verified:    true,
+			diagnostics: []string{"Error: Invalid E-Mail address"},
+		},
+		{
+			name: "unverified domain",
+			values: map[string]tftypes.Value{
+				"from": tftypes.NewValue(tftypes.String, "noreply@example.com"),
+				"to":   tftypes.NewValue(tftypes.String, "jane@example.org"),
+			},
+			messages:    []string{},
+			diagnostics: []string{"Error: Domain not verified"},
+		},
+		{
+			name: "unknown domain",
+			values: map[string]tftypes.Value{
+				"from": tftypes.NewValue(tftypes.String, "noreply@example.net"),
+				"to":   tftypes.NewValue(tftypes.String, "jane@example.org"),
+			},
+			verified:    true,
+			messages:    []string{},
+			diagnostics: []string{"Error: Unknown domain"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := &sendApi{DomainsApi: sweegomock.NewDomainsApi()}
+			if _, err := api.CreateDomain("", "example.com"); err != nil {
+				t.Fatal(err)
+			}
+			domain := api.Domains[sweegomock.DomainUuid(1)]
+			domain.IsVerified = test.verified
+			api.Domains[sweegomock.DomainUuid(1)] = domain
+
+			messages, diagnostics := invokeAction(t, &SweegoSendTestEmailAction{api: api}, test.values)
+			if !reflect.DeepEqual(messages, test.messages) {
+				t.Errorf("expected messages %#v, got %#v", test.messages, messages)
+			}
+			if summaries := diagnosticSummaries(diagnostics); !reflect.DeepEqual(summaries, append([]string{}, test.diagnostics...)) {
+				t.Errorf("expected diagnostics %v, got %v", test.diagnostics, diagnostics)
+			}
+			if (len(api.sent) == 1) != test.sent {
+				t.Fatalf("expected sent = %t, got %#v", test.sent, api.sent)
+			}
+			if test.sent && (api.sent[0].From.Email != "noreply@example.com" || api.sent[0].Subject != defaultTestEmailSubject) {
+				t.Errorf("unexpected E-Mail %#v", api.sent[0])
+			}
+		})
+	}
+}
+
+func TestSendTestEmailActionClientId(t *testing.T) {
+	api := &sendApi{DomainsApi: sweegomock.NewDomainsApi()}
+	if _, err := api.CreateDomain("", "example.com"); err != nil {
+		t.Fatal(err)
+	}
+	api.ResetCalls()
+
+	_, diagnostics := invokeAction(t, &SweegoSendTestEmailAction{api: api}, map[string]tftypes.Value{
+		"client_id": tftypes.NewValue(tftypes.String, "other"),
+		"from":      tftypes.NewValue(tftypes.String, "noreply@example.com"),
+		"to":        tftypes.NewValue(tftypes.String, "jane@example.org"),
+	})
+	if calls := api.ResetCalls(); len(calls) != 1 || calls[0].Method != "ListDomains" || calls[0].ClientId != "other" {
+		t.Errorf("expected the domains of client other to be listed, got %#v (%v)", calls, diagnostics)
+	}
+}
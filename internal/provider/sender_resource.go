@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ resource.Resource = &SweegoSenderResource{}
+var _ resource.ResourceWithImportState = &SweegoSenderResource{}
+var _ resource.ResourceWithValidateConfig = &SweegoSenderResource{}
+
+func NewSweegoSenderResource() resource.Resource {
+	return &SweegoSenderResource{}
+}
+
+// SweegoSenderResource defines the resource implementation.
+type SweegoSenderResource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoSenderResourceModel describes the resource data model.
+type SweegoSenderResourceModel struct {
+	ClientId   types.String `tfsdk:"client_id"`
+	Uuid       types.String `tfsdk:"uuid"`
+	DomainUuid types.String `tfsdk:"domain_uuid"`
+	Email      types.String `tfsdk:"email"`
+	Name       types.String `tfsdk:"name"`
+	ReplyTo    types.String `tfsdk:"reply_to"`
+	IsDefault  types.Bool   `tfsdk:"is_default"`
+}
+
+func (r *SweegoSenderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sender"
+}
+
+func (r *SweegoSenderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sender identity (From address and name) that is allowed to send E-Mails through a verified sweego domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideResourceAttribute,
+			"uuid": schema.StringAttribute{
+				Description: "UUID of the sender in sweego's system.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_uuid": schema.StringAttribute{
+				Description: "UUID of the sweego domain the sender belongs to (e.g. sweego_domain.my_domain.uuid). The domain must be verified.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "From address of the sender (e.g. jane@example.com, without display name). Must belong to the referenced domain.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name used in the From header",
+				Optional:    true,
+			},
+			"reply_to": schema.StringAttribute{
+				Description: "Address replies should be sent to (without display name)",
+				Optional:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether or not this is the default sender of the domain (defaults to false)",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SweegoSenderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = providerData.Api
+}
+
+func (r *SweegoSenderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SweegoSenderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Email.IsUnknown() && !data.Email.IsNull() {
+		if err := validateBareAddress(data.Email.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "Invalid E-Mail address", fmt.Sprintf("%#v is not a valid E-Mail address: %s", data.Email.ValueString(), err.Error()))
+		}
+	}
+	if !data.ReplyTo.IsUnknown() && !data.ReplyTo.IsNull() {
+		if err := validateBareAddress(data.ReplyTo.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reply_to"), "Invalid E-Mail address", fmt.Sprintf("%#v is not a valid E-Mail address: %s", data.ReplyTo.ValueString(), err.Error()))
+		}
+	}
+
+	// The domain can only be checked if the provider is configured and the domain already exists.
+	// Otherwise, the same check is done before creating the sender.
+	if r.api == nil || resp.Diagnostics.HasError() || data.DomainUuid.IsUnknown() || data.Email.IsUnknown() || data.ClientId.IsUnknown() {
+		return
+	}
+
+	validateSenderDomain(withLogger(ctx, r.api), data, &resp.Diagnostics)
+}
+
+func (r *SweegoSenderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweegoSenderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := withLogger(ctx, r.api)
+
+	validateSenderDomain(api, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sender, err := api.CreateSender(data.ClientId.ValueString(), data.DomainUuid.ValueString(), senderChangeRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating sender", err.Error())
+		return
+	}
+
+	data = fillSenderStateFromResponse(sender, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSenderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweegoSenderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sender, err := withLogger(ctx, r.api).GetSender(data.ClientId.ValueString(), data.DomainUuid.ValueString(), data.Uuid.ValueString())
+	if sweego.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading sender", fmt.Sprintf("Error reading sender: %s", err.Error()))
+		return
+	}
+
+	data = fillSenderStateFromResponse(sender, data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSenderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweegoSenderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api := withLogger(ctx, r.api)
+
+	validateSenderDomain(api, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sender, err := api.UpdateSender(data.ClientId.ValueString(), data.DomainUuid.ValueString(), data.Uuid.ValueString(), senderChangeRequest(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating sender", err.Error())
+		return
+	}
+
+	data = fillSenderStateFromResponse(sender, data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSenderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweegoSenderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).DeleteSender(data.ClientId.ValueString(), data.DomainUuid.ValueString(), data.Uuid.ValueString())
+	if err != nil && !sweego.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting sender", fmt.Sprintf("Error deleting sender: %s", err.Error()))
+	}
+}
+
+func (r *SweegoSenderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clientId, ids, err := parseImportId(req.ID, "domain_uuid/sender_uuid", 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	if clientId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_uuid"), ids[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), ids[1])...)
+}
+
+// validateSenderDomain makes sure that the sender address belongs to the referenced domain and
+// that the domain is verified - sweego will not send E-Mails from unverified domains.
+func validateSenderDomain(api *sweego.SweegoApi, data SweegoSenderResourceModel, diagnostics *diag.Diagnostics) {
+	domain, err := api.GetDomain(data.ClientId.ValueString(), data.DomainUuid.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("domain_uuid"), "Error reading domain", fmt.Sprintf("Error reading domain %s: %s", data.DomainUuid.ValueString(), err.Error()))
+		return
+	}
+
+	emailDomain, err := senderEmailDomain(data.Email.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("email"), "Invalid E-Mail address", fmt.Sprintf("%#v is not a valid E-Mail address: %s", data.Email.ValueString(), err.Error()))
+	} else if !strings.EqualFold(emailDomain, sweego.StripTrailingDot(domain.Domain)) {
+		diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Sender address does not belong to domain",
+			fmt.Sprintf("The sender address %s does not belong to the domain %s (%s). Use an address ending in @%s.", data.Email.ValueString(), domain.Domain, data.DomainUuid.ValueString(), domain.Domain),
+		)
+	}
+
+	if !domain.IsVerified {
+		diagnostics.AddAttributeError(
+			path.Root("domain_uuid"),
+			"Domain not verified",
+			fmt.Sprintf("The domain %s (%s) is not verified yet. Senders can only be added to verified domains: Create the DNS records of the domain and wait for sweego to verify them.", domain.Domain, data.DomainUuid.ValueString()),
+		)
+	}
+}
+
+// senderEmailDomain returns the domain of the E-Mail address. The address is parsed, so display names
+// (e.g. "Jane <jane@example.com>") and quoted local parts containing an @ are handled.
+func senderEmailDomain(email string) (string, error) {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", err
+	}
+	return address.Address[strings.LastIndex(address.Address, "@")+1:], nil
+}
+
+// validateBareAddress checks that the value is an E-Mail address that can be sent to the API as is. Display
+// names and comments (e.g. "Jane <jane@example.com>") are valid in headers, but not part of the address.
+func validateBareAddress(value string) error {
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return err
+	}
+	if address.Address != value {
+		return fmt.Errorf("Expected a bare address (e.g. %s) without display name or comment", address.Address)
+	}
+	return nil
+}
+
+func senderChangeRequest(data SweegoSenderResourceModel) sweego.SweegoSenderChangeRequest {
+	return sweego.SweegoSenderChangeRequest{
+		Email:     data.Email.ValueString(),
+		Name:      data.Name.ValueString(),
+		ReplyTo:   data.ReplyTo.ValueString(),
+		IsDefault: data.IsDefault.ValueBool(),
+	}
+}
+
+func fillSenderStateFromResponse(response sweego.SweegoSender, state SweegoSenderResourceModel) SweegoSenderResourceModel {
+	if response.Uuid != "" {
+		state.Uuid = types.StringValue(response.Uuid)
+	}
+	if response.DomainUuid != "" {
+		state.DomainUuid = types.StringValue(response.DomainUuid)
+	}
+	state.Email = types.StringValue(response.Email)
+	state.Name = optionalStringValue(response.Name)
+	state.ReplyTo = optionalStringValue(response.ReplyTo)
+	state.IsDefault = types.BoolValue(response.IsDefault)
+
+	return state
+}
+
+// optionalStringValue converts empty strings returned by the API to null values,
+// so that optional attributes that are not configured do not cause a diff.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import "testing"
+
+func TestSenderEmailDomain(t *testing.T) {
+	tests := []struct {
+		email    string
+		expected string
+		valid    bool
+	}{
+		{"jane@example.com", "example.com", true},
+		{"Jane Doe <jane@example.com>", "example.com", true},
+		{`"jane@other.org"@example.com`, "example.com", true},
+		{"jane@example.com (Jane)", "example.com", true},
+		{"jane", "", false},
+		{"jane@example.com, john@example.org", "", false},
+	}
+
+	for _, test := range tests {
+		domain, err := senderEmailDomain(test.email)
+		if (err == nil) != test.valid {
+			t.Errorf("senderEmailDomain(%#v) returned error %v, expected valid = %t", test.email, err, test.valid)
+		}
+		if domain != test.expected {
+			t.Errorf("senderEmailDomain(%#v) = %#v, expected %#v", test.email, domain, test.expected)
+		}
+	}
+}
+
+func TestValidateBareAddress(t *testing.T) {
+	tests := map[string]bool{
+		"jane@example.com":           true,
+		"jane+news@mail.example.com": true,
+		"Jane <jane@example.com>":    false,
+		"<jane@example.com>":         false,
+		"jane@example.com (Jane)":    false,
+		" jane@example.com":          false,
+		"jane":                       false,
+	}
+
+	for email, valid := range tests {
+		if err := validateBareAddress(email); (err == nil) != valid {
+			t.Errorf("validateBareAddress(%#v) returned error %v, expected valid = %t", email, err, valid)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ function.Function = &StripTrailingDotFunction{}
+
+func NewStripTrailingDotFunction() function.Function {
+	return &StripTrailingDotFunction{}
+}
+
+// StripTrailingDotFunction defines the function implementation.
+type StripTrailingDotFunction struct{}
+
+func (f *StripTrailingDotFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_trailing_dot"
+}
+
+func (f *StripTrailingDotFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Removes the trailing dot of a fully qualified DNS name",
+		Description: "Removes the trailing dot of a fully qualified DNS name (e.g. the data of CNAME records returned by sweego), as many DNS providers expect names without it. Values without a trailing dot are returned unchanged.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "DNS name, e.g. `abc.sweego.io.`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripTrailingDotFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sweego.StripTrailingDot(value)))
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStripTrailingDotFunction(t *testing.T) {
+	tests := map[string]string{
+		"abc.sweego.io.": "abc.sweego.io",
+		"abc.sweego.io":  "abc.sweego.io",
+		"":               "",
+	}
+	for value, expected := range tests {
+		result, err := runFunction(t, NewStripTrailingDotFunction(), types.StringValue(value))
+		if err != nil {
+			t.Fatalf("strip_trailing_dot(%#v) failed: %s", value, err)
+		}
+		if !result.Equal(types.StringValue(expected)) {
+			t.Errorf("strip_trailing_dot(%#v) = %s, expected %#v", value, result, expected)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ resource.Resource = &SweegoSuppressionResource{}
+var _ resource.ResourceWithImportState = &SweegoSuppressionResource{}
+
+func NewSweegoSuppressionResource() resource.Resource {
+	return &SweegoSuppressionResource{}
+}
+
+// SweegoSuppressionResource defines the resource implementation.
+type SweegoSuppressionResource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoSuppressionResourceModel describes the resource data model.
+type SweegoSuppressionResourceModel struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	Email        types.String `tfsdk:"email"`
+	Suppressed   types.Bool   `tfsdk:"suppressed"`
+	Reason       types.String `tfsdk:"reason"`
+	Domain       types.String `tfsdk:"domain"`
+	CreationDate types.String `tfsdk:"creation_date"`
+}
+
+func (r *SweegoSuppressionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppression"
+}
+
+func (r *SweegoSuppressionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Entry of the sweego suppression list. Can be used to pin an address on the suppression list or to make sure an address (e.g. a seed test inbox) is never suppressed.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideResourceAttribute,
+			"email": schema.StringAttribute{
+				Description: "E-Mail address the entry applies to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"suppressed": schema.BoolAttribute{
+				Description: "Whether the address should be on the suppression list (defaults to true). If set to false, the address will be removed from the suppression list whenever it is found on it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"reason": schema.StringAttribute{
+				Description: "Reason the address is suppressed (e.g. bounce, complaint, unsubscribe, manual). Only used when adding the address to the suppression list: If the address is already suppressed for another reason, the configured value is kept. If not configured, the reason reported by sweego is used.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "Sending domain the suppression applies to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Description: "Date the address was added to the suppression list",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SweegoSuppressionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.api = providerData.Api
+}
+
+func (r *SweegoSuppressionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SweegoSuppressionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.apply(withLogger(ctx, r.api), data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating suppression", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSuppressionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SweegoSuppressionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.read(withLogger(ctx, r.api), data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading suppression", fmt.Sprintf("Error reading suppression: %s", err.Error()))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSuppressionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SweegoSuppressionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.apply(withLogger(ctx, r.api), data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating suppression", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SweegoSuppressionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SweegoSuppressionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Addresses that are kept off the suppression list are simply no longer managed.
+	if !data.Suppressed.ValueBool() {
+		return
+	}
+
+	err := withLogger(ctx, r.api).DeleteSuppression(data.ClientId.ValueString(), data.Email.ValueString())
+	if err != nil && !sweego.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting suppression", fmt.Sprintf("Error deleting suppression: %s", err.Error()))
+	}
+}
+
+func (r *SweegoSuppressionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clientId, ids, err := parseImportId(req.ID, "email", 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	if clientId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientId)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), ids[0])...)
+}
+
+// apply adds the address to or removes it from the suppression list, depending on the
+// desired `suppressed` value, and reads back the resulting state.
+func (r *SweegoSuppressionResource) apply(api *sweego.SweegoApi, data SweegoSuppressionResourceModel) (SweegoSuppressionResourceModel, error) {
+	if data.Suppressed.ValueBool() {
+		_, err := api.GetSuppression(data.ClientId.ValueString(), data.Email.ValueString())
+		if sweego.IsNotFound(err) {
+			_, err = api.AddSuppression(data.ClientId.ValueString(), sweego.SweegoSuppressionCreateRequest{
+				Email:  data.Email.ValueString(),
+				Reason: data.Reason.ValueString(),
+			})
+		}
+		if err != nil {
+			return data, err
+		}
+	} else {
+		err := api.DeleteSuppression(data.ClientId.ValueString(), data.Email.ValueString())
+		if err != nil && !sweego.IsNotFound(err) {
+			return data, err
+		}
+	}
+
+	return r.read(api, data)
+}
+
+// read fills the state from the suppression list. An address that is not on the list is
+// represented by `suppressed = false` instead of being removed from the state, so that
+// drift in both directions is detected. A configured reason is kept, as it cannot be changed
+// for addresses that are already suppressed.
+func (r *SweegoSuppressionResource) read(api *sweego.SweegoApi, data SweegoSuppressionResourceModel) (SweegoSuppressionResourceModel, error) {
+	suppression, err := api.GetSuppression(data.ClientId.ValueString(), data.Email.ValueString())
+	if sweego.IsNotFound(err) {
+		data.Suppressed = types.BoolValue(false)
+		if data.Reason.IsUnknown() {
+			data.Reason = types.StringNull()
+		}
+		data.Domain = types.StringNull()
+		data.CreationDate = types.StringNull()
+		return data, nil
+	}
+	if err != nil {
+		return data, err
+	}
+
+	data.Suppressed = types.BoolValue(true)
+	if data.Reason.IsNull() || data.Reason.IsUnknown() {
+		data.Reason = types.StringValue(suppression.Reason)
+	}
+	data.Domain = types.StringValue(suppression.Domain)
+	data.CreationDate = types.StringValue(suppression.CreationDate)
+	return data, nil
+}
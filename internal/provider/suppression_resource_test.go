@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+type discardLogger struct{}
+
+func (discardLogger) Info(string)  {}
+func (discardLogger) Error(string) {}
+func (discardLogger) Debug(string) {}
+
+// newSuppressionApi returns a client of a server knowing only the suppression of suppressed@example.com.
+func newSuppressionApi(t *testing.T) *sweego.SweegoApi {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if !strings.HasSuffix(r.URL.Path, "/suppressions/suppressed@example.com") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"detail":"Not Found"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"email":"suppressed@example.com","reason":"bounce","domain":"example.com","creation_dt":"2026-10-19T12:00:00Z"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return sweego.NewSweegoApi("key", "client", sweego.WithBaseUrl(server.URL), sweego.WithLogger(discardLogger{}))
+}
+
+func TestSuppressionRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		email      string
+		reason     types.String
+		suppressed bool
+		expected   types.String
+	}{
+		{"configured reason is kept", "suppressed@example.com", types.StringValue("manual"), true, types.StringValue("manual")},
+		{"unconfigured reason is read", "suppressed@example.com", types.StringNull(), true, types.StringValue("bounce")},
+		{"unknown reason is read", "suppressed@example.com", types.StringUnknown(), true, types.StringValue("bounce")},
+		{"configured reason of unsuppressed address is kept", "other@example.com", types.StringValue("manual"), false, types.StringValue("manual")},
+		{"unknown reason of unsuppressed address is null", "other@example.com", types.StringUnknown(), false, types.StringNull()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := (&SweegoSuppressionResource{}).read(newSuppressionApi(t), SweegoSuppressionResourceModel{
+				ClientId: types.StringNull(),
+				Email:    types.StringValue(test.email),
+				Reason:   test.reason,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if data.Suppressed.ValueBool() != test.suppressed {
+				t.Errorf("expected suppressed = %t, got %s", test.suppressed, data.Suppressed)
+			}
+			if !data.Reason.Equal(test.expected) {
+				t.Errorf("expected reason %s, got %s", test.expected, data.Reason)
+			}
+		})
+	}
+}
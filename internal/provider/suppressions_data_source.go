@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ datasource.DataSource = &SweegoSuppressionsDataSource{}
+
+func NewSweegoSuppressionsDataSource() datasource.DataSource {
+	return &SweegoSuppressionsDataSource{}
+}
+
+// SweegoSuppressionsDataSource defines the data source implementation.
+type SweegoSuppressionsDataSource struct {
+	api *sweego.SweegoApi
+}
+
+// SweegoSuppressionsDataSourceModel describes the data source data model.
+type SweegoSuppressionsDataSourceModel struct {
+	ClientId      types.String                      `tfsdk:"client_id"`
+	Reason        types.String                      `tfsdk:"reason"`
+	Domain        types.String                      `tfsdk:"domain"`
+	CreatedAfter  types.String                      `tfsdk:"created_after"`
+	CreatedBefore types.String                      `tfsdk:"created_before"`
+	Suppressions  []SweegoSuppressionDataSourceItem `tfsdk:"suppressions"`
+}
+
+type SweegoSuppressionDataSourceItem struct {
+	Email        types.String `tfsdk:"email"`
+	Reason       types.String `tfsdk:"reason"`
+	Domain       types.String `tfsdk:"domain"`
+	CreationDate types.String `tfsdk:"creation_date"`
+}
+
+func (d *SweegoSuppressionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suppressions"
+}
+
+func (d *SweegoSuppressionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists entries of the sweego suppression list, e.g. in order to audit bounced or unsubscribed addresses.",
+
+		Attributes: map[string]schema.Attribute{
+			"client_id": clientIdOverrideDataSourceAttribute,
+			"reason": schema.StringAttribute{
+				Description: "Only list entries with the given reason (e.g. bounce, complaint, unsubscribe, manual)",
+				Optional:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Only list entries for the given sending domain",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only list entries created after the given date (RFC 3339, e.g. 2026-01-01T00:00:00Z)",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only list entries created before the given date (RFC 3339, e.g. 2026-02-01T00:00:00Z)",
+				Optional:    true,
+			},
+			"suppressions": schema.ListNestedAttribute{
+				Description: "Entries of the suppression list matching the filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							Description: "Suppressed E-Mail address",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Reason the address is suppressed",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Sending domain the suppression applies to",
+							Computed:    true,
+						},
+						"creation_date": schema.StringAttribute{
+							Description: "Date the address was added to the suppression list",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SweegoSuppressionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*SweegoProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.SweegoProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.api = providerData.Api
+}
+
+func (d *SweegoSuppressionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SweegoSuppressionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	suppressions, err := withLogger(ctx, d.api).ListSuppressions(data.ClientId.ValueString(), sweego.SweegoSuppressionFilter{
+		Reason:        data.Reason.ValueString(),
+		Domain:        data.Domain.ValueString(),
+		CreatedAfter:  data.CreatedAfter.ValueString(),
+		CreatedBefore: data.CreatedBefore.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing suppressions", fmt.Sprintf("Error listing suppressions: %s", err.Error()))
+		return
+	}
+
+	data.Suppressions = make([]SweegoSuppressionDataSourceItem, len(suppressions))
+	for i, suppression := range suppressions {
+		data.Suppressions[i] = SweegoSuppressionDataSourceItem{
+			Email:        types.StringValue(suppression.Email),
+			Reason:       types.StringValue(suppression.Reason),
+			Domain:       types.StringValue(suppression.Domain),
+			CreationDate: types.StringValue(suppression.CreationDate),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
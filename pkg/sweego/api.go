@@ -0,0 +1,79 @@
+package sweego
+
+import (
+	"context"
+	"net/http"
+)
+
+// DefaultBaseUrl is the URL of the sweego API.
+const DefaultBaseUrl = "https://api.sweego.io/"
+
+// DefaultUserAgent is sent with every request, unless another User-Agent is configured using WithUserAgent.
+const DefaultUserAgent = "sweego-go"
+
+// SweegoApi is an authenticated client of the sweego API. It is safe for concurrent use.
+//
+// Most methods take the ID of the client objects belong to as first parameter. If it is empty,
+// the client ID the API was created with is used.
+type SweegoApi struct {
+	baseUrl    string
+	apiKey     string
+	clientId   string
+	httpClient *http.Client
+	logger     SweegoApiLogger
+	userAgent  string
+	retry      SweegoRetryPolicy
+	// limiter is shared by all copies of the API
+	limiter *rateLimiter
+	// ctx cancels requests and waits for retries or the rate limit, see WithContext
+	ctx context.Context
+}
+
+// WithLogger returns a copy of the API that logs to the given logger.
+func (api *SweegoApi) WithLogger(logger SweegoApiLogger) *SweegoApi {
+	copy := *api
+	copy.logger = logger
+	return &copy
+}
+
+// WithContext returns a copy of the API whose requests are cancelled with the given context. Waiting for
+// retries or the rate limit is cancelled as well.
+func (api *SweegoApi) WithContext(ctx context.Context) *SweegoApi {
+	copy := *api
+	copy.ctx = ctx
+	return &copy
+}
+
+// NewSweegoApi creates a client for the sweego API, authenticated using the given API key.
+// The default configuration can be changed using options (e.g. WithBaseUrl or WithRetry).
+func NewSweegoApi(apiKey string, clientId string, options ...SweegoApiOption) *SweegoApi {
+	api := &SweegoApi{
+		baseUrl:    DefaultBaseUrl,
+		apiKey:     apiKey,
+		clientId:   clientId,
+		httpClient: &http.Client{},
+		logger:     GolangLogger{},
+		userAgent:  DefaultUserAgent,
+		limiter:    newRateLimiter(0, 1),
+		ctx:        context.Background(),
+	}
+	for _, option := range options {
+		option(api)
+	}
+	return api
+}
+
+// NewSweegoApiWithBaseUrl creates a client for the sweego API at a different URL.
+// It is equivalent to NewSweegoApi(apiKey, clientId, WithBaseUrl(baseUrl)).
+func NewSweegoApiWithBaseUrl(baseUrl string, apiKey string, clientId string) *SweegoApi {
+	return NewSweegoApi(apiKey, clientId, WithBaseUrl(baseUrl))
+}
+
+// resolveClientId returns the client ID requests should be sent for: The given client ID or,
+// if it is empty, the client ID the API was created with.
+func (api *SweegoApi) resolveClientId(clientId string) string {
+	if clientId == "" {
+		return api.clientId
+	}
+	return clientId
+}
@@ -0,0 +1,64 @@
+package sweego
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SweegoClient is a (sub-)client. Objects like domains always belong to a client.
+type SweegoClient struct {
+	Id           int64  `json:"id"`
+	ParentId     int64  `json:"parent_id"`
+	Name         string `json:"name"`
+	CreationDate string `json:"creation_dt"`
+}
+
+// SweegoClientChangeRequest contains the writable properties of a sub-client.
+type SweegoClientChangeRequest struct {
+	Name string `json:"name"`
+}
+
+// ListClients returns all sub-clients of the client.
+func (api *SweegoApi) ListClients(clientId string) ([]SweegoClient, error) {
+	api.logger.Debug(fmt.Sprintf("ListClients(%#v)", clientId))
+
+	return fetchAllPages[SweegoClient](api, fmt.Sprintf("clients/%s/sub-clients", api.resolveClientId(clientId)), nil)
+}
+
+// IterateClients returns an iterator over all sub-clients of the client. Further pages are requested while iterating.
+func (api *SweegoApi) IterateClients(clientId string) iter.Seq2[SweegoClient, error] {
+	api.logger.Debug(fmt.Sprintf("IterateClients(%#v)", clientId))
+
+	return paginate[SweegoClient](api, fmt.Sprintf("clients/%s/sub-clients", api.resolveClientId(clientId)), nil)
+}
+
+func (api *SweegoApi) GetClient(clientId string, id string) (SweegoClient, error) {
+	api.logger.Debug(fmt.Sprintf("GetClient(%#v, %#v)", clientId, id))
+
+	var response SweegoClient
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/sub-clients/%s", api.resolveClientId(clientId), id), &response)
+	return response, err
+}
+
+// CreateClient creates a sub-client of the client.
+func (api *SweegoApi) CreateClient(clientId string, client SweegoClientChangeRequest) (SweegoClient, error) {
+	api.logger.Debug(fmt.Sprintf("CreateClient(%#v, %#v)", clientId, client))
+
+	var response SweegoClient
+	err := api.executeJsonRequest("POST", fmt.Sprintf("clients/%s/sub-clients", api.resolveClientId(clientId)), client, &response)
+	return response, err
+}
+
+func (api *SweegoApi) UpdateClient(clientId string, id string, client SweegoClientChangeRequest) (SweegoClient, error) {
+	api.logger.Debug(fmt.Sprintf("UpdateClient(%#v, %#v, %#v)", clientId, id, client))
+
+	var response SweegoClient
+	err := api.executeJsonRequest("PUT", fmt.Sprintf("clients/%s/sub-clients/%s", api.resolveClientId(clientId), id), client, &response)
+	return response, err
+}
+
+func (api *SweegoApi) DeleteClient(clientId string, id string) error {
+	api.logger.Debug(fmt.Sprintf("DeleteClient(%#v, %#v)", clientId, id))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/sub-clients/%s", api.resolveClientId(clientId), id), nil)
+}
@@ -0,0 +1,186 @@
+package sweego
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SweegoDomainListInformation is the summary of a domain returned by ListDomains.
+type SweegoDomainListInformation struct {
+	Id                   int64  `json:"id"`
+	ClientId             int64  `json:"client_id"`
+	Uuid                 string `json:"uuid"`
+	CreationDate         string `json:"creation_dt"`
+	LastVerificationDate string `json:"last_verification_dt"`
+	TrackingOpenEnabled  bool   `json:"tracking_open_enabled"`
+	TrackingClickEnabled bool   `json:"tracking_click_enabled"`
+	TrackingSubdomain    string `json:"tracking_subdomain"`
+	TrackingHttpsEnabled bool   `json:"tracking_https_enabled"`
+	IsVerified           bool   `json:"is_verified"`
+	Domain               string `json:"domain"`
+}
+
+// SweegoDomainRecord is a DNS record that needs to be published for a domain.
+type SweegoDomainRecord struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Data     string `json:"data"`
+	Verified bool   `json:"verified"`
+}
+
+// SweegoDomainDetails is a domain including all DNS records that need to be published for it.
+type SweegoDomainDetails struct {
+	Uuid                 string               `json:"uuid"`
+	IsVerified           bool                 `json:"is_verified"`
+	TrackingOpenEnabled  bool                 `json:"tracking_open_enabled"`
+	TrackingClickEnabled bool                 `json:"tracking_click_enabled"`
+	Domain               string               `json:"domain"`
+	DomainRecord         SweegoDomainRecord   `json:"domain_record"`
+	DkimRecord           SweegoDomainRecord   `json:"dkim_record"`
+	DmarcRecord          SweegoDomainRecord   `json:"dmarc_record"`
+	InboundRecordList    []SweegoDomainRecord `json:"inbound_record_list"`
+	TrackingRecord       SweegoDomainRecord   `json:"tracking_record"`
+	// TrackingSubdomain is the domain tracked links point to (e.g. links.example.com) and the name of
+	// TrackingRecord. It is empty if the default of sweego is used.
+	TrackingSubdomain    string `json:"tracking_subdomain"`
+	TrackingHttpsEnabled bool   `json:"tracking_https_enabled"`
+	// NextDkimRecord is only set while a DKIM rotation is in progress: Both DKIM records need to be
+	// published until the new one is verified and the rotation is completed.
+	NextDkimRecord SweegoDomainRecord `json:"next_dkim_record"`
+}
+
+// SweegoDomainCheckSingleResult is the verification result of a single DNS record.
+type SweegoDomainCheckSingleResult struct {
+	Verified    bool   `json:"verified"`
+	ErrorString string `json:"error_string"`
+}
+
+// SweegoDomainCheckResult is the verification result of all DNS records of a domain.
+type SweegoDomainCheckResult struct {
+	// DomainRecord is the result of DomainRecord of the domain, which sweego verifies SPF with. The API
+	// reports it as spf_record.
+	DomainRecord      SweegoDomainCheckSingleResult   `json:"spf_record"`
+	DkimRecord        SweegoDomainCheckSingleResult   `json:"dkim_record"`
+	DmarcRecord       SweegoDomainCheckSingleResult   `json:"dmarc_record"`
+	InboundRecordList []SweegoDomainCheckSingleResult `json:"inbound_record_list"`
+	TrackingRecord    SweegoDomainCheckSingleResult   `json:"tracking_record"`
+}
+
+// SweegoDomainCheckRecordResult is the check result of a single record, labelled with the record it belongs to.
+type SweegoDomainCheckRecordResult struct {
+	Record string
+	SweegoDomainCheckSingleResult
+}
+
+// Records returns the results of all checked records in a stable order.
+func (result SweegoDomainCheckResult) Records() []SweegoDomainCheckRecordResult {
+	records := []SweegoDomainCheckRecordResult{
+		{"DKIM", result.DkimRecord},
+		{"DMARC", result.DmarcRecord},
+		{"Domain (SPF)", result.DomainRecord},
+		{"Tracking", result.TrackingRecord},
+	}
+	for i, inboundResult := range result.InboundRecordList {
+		records = append(records, SweegoDomainCheckRecordResult{fmt.Sprintf("Inbound[%d]", i), inboundResult})
+	}
+	return records
+}
+
+// Verification returns the verification status of the records as of the last check by sweego. Unlike Check,
+// it does not make sweego verify the records again, and the results do not contain an error description.
+func (domain SweegoDomainDetails) Verification() SweegoDomainCheckResult {
+	result := SweegoDomainCheckResult{
+		DomainRecord:   SweegoDomainCheckSingleResult{Verified: domain.DomainRecord.Verified},
+		DkimRecord:     SweegoDomainCheckSingleResult{Verified: domain.DkimRecord.Verified},
+		DmarcRecord:    SweegoDomainCheckSingleResult{Verified: domain.DmarcRecord.Verified},
+		TrackingRecord: SweegoDomainCheckSingleResult{Verified: domain.TrackingRecord.Verified},
+	}
+	for _, record := range domain.InboundRecordList {
+		result.InboundRecordList = append(result.InboundRecordList, SweegoDomainCheckSingleResult{Verified: record.Verified})
+	}
+	return result
+}
+
+// SweegoTrackingChangeRequest configures open and click tracking of a domain. Open and click tracking are
+// replaced, the subdomain and HTTPS setting are kept if nil. An empty TrackingSubdomain resets the tracking
+// domain to the default of sweego. Changing the subdomain changes the tracking record of the domain.
+type SweegoTrackingChangeRequest struct {
+	ClickTrackingEnabled bool    `json:"click_enabled"`
+	OpenTrackingEnabled  bool    `json:"open_enabled"`
+	TrackingSubdomain    *string `json:"subdomain,omitempty"`
+	HttpsEnabled         *bool   `json:"https_enabled,omitempty"`
+}
+
+func (api *SweegoApi) ListDomains(clientId string) ([]SweegoDomainListInformation, error) {
+	api.logger.Debug(fmt.Sprintf("ListDomains(%#v)", clientId))
+
+	return fetchAllPages[SweegoDomainListInformation](api, fmt.Sprintf("clients/%s/domains", api.resolveClientId(clientId)), nil)
+}
+
+// IterateDomains returns an iterator over all domains of the client. Further pages are requested while iterating.
+func (api *SweegoApi) IterateDomains(clientId string) iter.Seq2[SweegoDomainListInformation, error] {
+	api.logger.Debug(fmt.Sprintf("IterateDomains(%#v)", clientId))
+
+	return paginate[SweegoDomainListInformation](api, fmt.Sprintf("clients/%s/domains", api.resolveClientId(clientId)), nil)
+}
+
+func (api *SweegoApi) GetDomain(clientId string, uuid string) (SweegoDomainDetails, error) {
+	api.logger.Debug(fmt.Sprintf("GetDomain(%#v, %#v)", clientId, uuid))
+
+	var response SweegoDomainDetails
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/domains/%s", api.resolveClientId(clientId), uuid), &response)
+	return response, err
+}
+
+func (api *SweegoApi) CreateDomain(clientId string, domain string) (SweegoDomainDetails, error) {
+	api.logger.Debug(fmt.Sprintf("CreateDomain(%#v, %#v)", clientId, domain))
+
+	var response SweegoDomainDetails
+	err := api.executeJsonRequest(
+		"POST",
+		fmt.Sprintf("clients/%s/domains", api.resolveClientId(clientId)),
+		map[string]string{"domain": domain},
+		&response,
+	)
+
+	return response, err
+}
+
+func (api *SweegoApi) DeleteDomain(clientId string, uuid string) error {
+	api.logger.Debug(fmt.Sprintf("DeleteDomain(%#v, %#v)", clientId, uuid))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/domains/%s", api.resolveClientId(clientId), uuid), nil)
+}
+
+func (api *SweegoApi) Check(clientId string, uuid string) (SweegoDomainCheckResult, error) {
+	api.logger.Debug(fmt.Sprintf("Check(%#v, %#v)", clientId, uuid))
+
+	var response SweegoDomainCheckResult
+	err := api.executePlainRequest("POST", fmt.Sprintf("clients/%s/domains/%s/check", api.resolveClientId(clientId), uuid), &response)
+
+	return response, err
+}
+
+func (api *SweegoApi) UpdateTracking(clientId string, uuid string, tracking SweegoTrackingChangeRequest) error {
+	api.logger.Debug(fmt.Sprintf("UpdateTracking(%#v, %#v, %#v)", clientId, uuid, tracking))
+
+	return api.executeJsonRequest("PUT", fmt.Sprintf("clients/%s/domains/%s/tracking", api.resolveClientId(clientId), uuid), tracking, nil)
+}
+
+// RotateDkim provisions a new DKIM selector for the domain. The new record is returned as NextDkimRecord of
+// the domain until the rotation is completed using CompleteDkimRotation.
+func (api *SweegoApi) RotateDkim(clientId string, uuid string) (SweegoDomainRecord, error) {
+	api.logger.Debug(fmt.Sprintf("RotateDkim(%#v, %#v)", clientId, uuid))
+
+	var response SweegoDomainRecord
+	err := api.executePlainRequest("POST", fmt.Sprintf("clients/%s/domains/%s/dkim/rotation", api.resolveClientId(clientId), uuid), &response)
+
+	return response, err
+}
+
+// CompleteDkimRotation retires the old DKIM selector of the domain. The new selector must be verified.
+func (api *SweegoApi) CompleteDkimRotation(clientId string, uuid string) error {
+	api.logger.Debug(fmt.Sprintf("CompleteDkimRotation(%#v, %#v)", clientId, uuid))
+
+	return api.executePlainRequest("POST", fmt.Sprintf("clients/%s/domains/%s/dkim/rotation/complete", api.resolveClientId(clientId), uuid), nil)
+}
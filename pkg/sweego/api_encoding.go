@@ -0,0 +1,132 @@
+package sweego
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+)
+
+// SweegoRequestEncoder serializes the body of a request sent using SweegoApi.Do. The body is encoded once
+// and sent with every attempt.
+type SweegoRequestEncoder interface {
+	// Encode returns the serialized body and its content type.
+	Encode() ([]byte, string, error)
+}
+
+// SweegoResponseDecoder parses the body of a successful response to a request sent using SweegoApi.Do.
+type SweegoResponseDecoder interface {
+	Decode(contentType string, body []byte) error
+}
+
+// SweegoJsonEncoder serializes the body as JSON.
+type SweegoJsonEncoder struct {
+	Body any
+}
+
+func (encoder SweegoJsonEncoder) Encode() ([]byte, string, error) {
+	data, err := json.Marshal(encoder.Body)
+	return data, "application/json", err
+}
+
+// SweegoFormEncoder serializes the values as application/x-www-form-urlencoded body.
+type SweegoFormEncoder struct {
+	Values url.Values
+}
+
+func (encoder SweegoFormEncoder) Encode() ([]byte, string, error) {
+	return []byte(encoder.Values.Encode()), "application/x-www-form-urlencoded", nil
+}
+
+// SweegoMultipartFile is a file part of a multipart body.
+type SweegoMultipartFile struct {
+	FieldName string
+	Filename  string
+	// ContentType defaults to application/octet-stream
+	ContentType string
+	Data        []byte
+}
+
+// SweegoMultipartEncoder serializes fields and files as multipart/form-data body, e.g. for uploads.
+type SweegoMultipartEncoder struct {
+	Fields url.Values
+	Files  []SweegoMultipartFile
+}
+
+func (encoder SweegoMultipartEncoder) Encode() ([]byte, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for name, values := range encoder.Fields {
+		for _, value := range values {
+			if err := writer.WriteField(name, value); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	for _, file := range encoder.Files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", multipart.FileContentDisposition(file.FieldName, file.Filename))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, err := part.Write(file.Data); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body.Bytes(), writer.FormDataContentType(), nil
+}
+
+// SweegoRawEncoder sends the data as it is, e.g. CSV files.
+type SweegoRawEncoder struct {
+	ContentType string
+	Data        []byte
+}
+
+func (encoder SweegoRawEncoder) Encode() ([]byte, string, error) {
+	return encoder.Data, encoder.ContentType, nil
+}
+
+// SweegoJsonDecoder parses the response as JSON into Target. Nothing is parsed if Target is nil.
+type SweegoJsonDecoder struct {
+	Target any
+}
+
+func (decoder SweegoJsonDecoder) Decode(contentType string, body []byte) error {
+	if decoder.Target == nil {
+		return nil
+	}
+	return json.Unmarshal(body, decoder.Target)
+}
+
+// SweegoRawDecoder stores the response body as it is, e.g. for CSV exports.
+type SweegoRawDecoder struct {
+	Target *[]byte
+	// ContentType is set to the content type of the response, if not nil
+	ContentType *string
+}
+
+func (decoder SweegoRawDecoder) Decode(contentType string, body []byte) error {
+	if decoder.Target == nil {
+		return fmt.Errorf("no target for the response body")
+	}
+	*decoder.Target = body
+	if decoder.ContentType != nil {
+		*decoder.ContentType = contentType
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package sweego
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncoders(t *testing.T) {
+	tests := []struct {
+		name        string
+		encoder     SweegoRequestEncoder
+		body        string
+		contentType string
+	}{
+		{
+			name:        "json",
+			encoder:     SweegoJsonEncoder{Body: map[string]any{"domain": "example.com", "open_enabled": true}},
+			body:        `{"domain":"example.com","open_enabled":true}`,
+			contentType: "application/json",
+		},
+		{
+			name:        "json without body",
+			encoder:     SweegoJsonEncoder{},
+			body:        `null`,
+			contentType: "application/json",
+		},
+		{
+			name:        "form",
+			encoder:     SweegoFormEncoder{Values: url.Values{"email": {"a+b@example.com"}, "reason": {"bounce"}}},
+			body:        "email=a%2Bb%40example.com&reason=bounce",
+			contentType: "application/x-www-form-urlencoded",
+		},
+		{
+			name:        "form without values",
+			encoder:     SweegoFormEncoder{},
+			body:        "",
+			contentType: "application/x-www-form-urlencoded",
+		},
+		{
+			name:        "raw",
+			encoder:     SweegoRawEncoder{ContentType: "text/csv", Data: []byte("email\na@example.com\n")},
+			body:        "email\na@example.com\n",
+			contentType: "text/csv",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, contentType, err := test.encoder.Encode()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(body) != test.body {
+				t.Errorf("expected body %#v, got %#v", test.body, string(body))
+			}
+			if contentType != test.contentType {
+				t.Errorf("expected content type %#v, got %#v", test.contentType, contentType)
+			}
+		})
+	}
+}
+
+func TestMultipartEncoder(t *testing.T) {
+	encoder := SweegoMultipartEncoder{
+		Fields: url.Values{"reason": {"bounce"}},
+		Files: []SweegoMultipartFile{
+			{FieldName: "file", Filename: "suppressions.csv", ContentType: "text/csv", Data: []byte("email\na@example.com\n")},
+			{FieldName: "attachment", Filename: "data.bin", Data: []byte{0, 1, 2}},
+		},
+	}
+
+	body, contentType, err := encoder.Encode()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+		t.Fatalf("expected multipart/form-data with boundary, got %#v (%v)", contentType, err)
+	}
+
+	type part struct {
+		FormName    string
+		FileName    string
+		ContentType string
+		Data        string
+	}
+	parts := []part{}
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("cannot read part: %s", err)
+		}
+		data, _ := io.ReadAll(p)
+		parts = append(parts, part{p.FormName(), p.FileName(), p.Header.Get("Content-Type"), string(data)})
+	}
+
+	expected := []part{
+		{"reason", "", "", "bounce"},
+		{"file", "suppressions.csv", "text/csv", "email\na@example.com\n"},
+		{"attachment", "data.bin", "application/octet-stream", "\x00\x01\x02"},
+	}
+	if !reflect.DeepEqual(parts, expected) {
+		t.Errorf("expected parts %#v, got %#v", expected, parts)
+	}
+}
+
+func TestDecoders(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		var target SweegoDomainRecord
+		err := SweegoJsonDecoder{Target: &target}.Decode("application/json", []byte(`{"name":"swg","type":"CNAME","data":"a.sweego.io.","verified":true}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		expected := SweegoDomainRecord{Name: "swg", Type: "CNAME", Data: "a.sweego.io.", Verified: true}
+		if target != expected {
+			t.Errorf("expected %#v, got %#v", expected, target)
+		}
+	})
+
+	t.Run("json without target", func(t *testing.T) {
+		if err := (SweegoJsonDecoder{}).Decode("text/plain", []byte("not json")); err != nil {
+			t.Errorf("expected the body to be ignored, got %s", err)
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		var target SweegoDomainRecord
+		if err := (SweegoJsonDecoder{Target: &target}).Decode("application/json", []byte("<html>")); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		var target []byte
+		var contentType string
+		err := SweegoRawDecoder{Target: &target, ContentType: &contentType}.Decode("text/csv", []byte("email\n"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(target) != "email\n" || contentType != "text/csv" {
+			t.Errorf("expected CSV body and content type, got %#v (%#v)", string(target), contentType)
+		}
+	})
+
+	t.Run("raw without target", func(t *testing.T) {
+		if err := (SweegoRawDecoder{}).Decode("text/csv", []byte("email\n")); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestDoSendsEncodedBody(t *testing.T) {
+	api, server, _ := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = io.WriteString(w, "email\na@example.com\n")
+	})
+
+	var response []byte
+	var contentType string
+	err := api.Do("POST", "clients/test-client/suppressions/import", SweegoFormEncoder{Values: url.Values{"reason": {"bounce"}}}, SweegoRawDecoder{Target: &response, ContentType: &contentType})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if requests[0].Path != "/clients/test-client/suppressions/import" || requests[0].ContentType != "application/x-www-form-urlencoded" || string(requests[0].Body) != "reason=bounce" {
+		t.Errorf("unexpected request %#v", requests[0])
+	}
+	if string(response) != "email\na@example.com\n" || !strings.HasPrefix(contentType, "text/csv") {
+		t.Errorf("unexpected response %#v (%#v)", string(response), contentType)
+	}
+}
+
+func TestDoReturnsHttpErrors(t *testing.T) {
+	api, _, _ := newTestApi(t, respond(http.StatusNotFound, `{"detail":"Not Found"}`))
+
+	err := api.Do("GET", "clients/test-client/unknown", nil, nil)
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package sweego
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SweegoHttpError is returned if the API responds with a non-20x status code.
+type SweegoHttpError struct {
+	Method     string
+	Url        string
+	StatusCode int
+	Body       []byte
+}
+
+func (err *SweegoHttpError) Error() string {
+	return fmt.Sprintf("Error executing request %s %s: Invalid response status code: %d\n%s\n", err.Method, err.Url, err.StatusCode, err.Body)
+}
+
+// IsNotFound returns true if the given error was caused by the API responding with 404 Not Found.
+func IsNotFound(err error) bool {
+	var httpErr *SweegoHttpError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+}
+
+func (api *SweegoApi) executeRequest(
+	method string,
+	endpoint string,
+	body SweegoRequestEncoder,
+	decoder SweegoResponseDecoder,
+) error {
+	headers := map[string]string{}
+	absUrl := fmt.Sprintf("%s/%s", strings.TrimRight(api.baseUrl, "/"), strings.TrimLeft(endpoint, "/"))
+
+	headers["Accept"] = "application/json"
+	headers["Api-Key"] = api.apiKey
+
+	var bodyBytes []byte
+	if body != nil {
+		var contentType string
+		var err error
+		bodyBytes, contentType, err = body.Encode()
+		if err != nil {
+			return fmt.Errorf("Error executing request %s %s: Cannot serialize body: %s", method, absUrl, err)
+		}
+		headers["Content-Type"] = contentType
+	}
+
+	var response *http.Response
+	var responseBody []byte
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequestWithContext(api.ctx, method, absUrl, bytes.NewReader(bodyBytes))
+		if err != nil {
+			return fmt.Errorf("Error executing request %s %s: Cannot initialize request: %s", method, absUrl, err)
+		}
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+		if api.userAgent != "" {
+			request.Header.Set("User-Agent", api.userAgent)
+		}
+
+		if delay := api.limiter.reserve(); delay > 0 {
+			api.logger.Debug(fmt.Sprintf("Rate limit: Delaying request by %s", delay))
+			if err := api.sleep(delay); err != nil {
+				return fmt.Errorf("Error executing request %s %s: Cancelled while waiting for the rate limit: %s", method, absUrl, err)
+			}
+		}
+
+		api.logger.Debug(fmt.Sprintf("%s %s\n%#v", request.Method, request.URL, request))
+		response, err = api.httpClient.Do(request)
+		if err != nil {
+			if api.ctx.Err() == nil && api.shouldRetry(attempt, method, 0) {
+				if err := api.waitForRetry(attempt, nil); err != nil {
+					return fmt.Errorf("Error executing request %s %s: Cancelled while waiting for retry: %s", method, absUrl, err)
+				}
+				continue
+			}
+			return fmt.Errorf("Error executing request %s %s: Error executing request: %s", method, absUrl, err)
+		}
+		api.logger.Debug(fmt.Sprintf("%#v", response))
+		if rate := api.limiter.observe(response.Header); rate > 0 {
+			api.logger.Info(fmt.Sprintf("Rate limit quota of the API is low, slowing down to %.2f requests per second", rate))
+		}
+
+		responseBody, err = io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return fmt.Errorf("Error executing request %s %s: Cannot read response body: %s", method, absUrl, err)
+		}
+
+		api.logger.Debug(string(responseBody))
+
+		if api.shouldRetry(attempt, method, response.StatusCode) {
+			if err := api.waitForRetry(attempt, response); err != nil {
+				return fmt.Errorf("Error executing request %s %s: Cancelled while waiting for retry: %s", method, absUrl, err)
+			}
+			continue
+		}
+		break
+	}
+
+	if response.StatusCode >= 300 {
+		return &SweegoHttpError{
+			Method:     method,
+			Url:        absUrl,
+			StatusCode: response.StatusCode,
+			Body:       responseBody,
+		}
+	}
+
+	if decoder != nil {
+		err := decoder.Decode(response.Header.Get("Content-Type"), responseBody)
+		if err != nil {
+			return fmt.Errorf("Error executing request %s %s: Cannot parse response body: %s\n%s", method, absUrl, err, responseBody)
+		}
+	}
+
+	return nil
+}
+
+// shouldRetry decides whether a failed attempt should be retried according to the retry policy.
+// statusCode is 0 if the API could not be reached.
+func (api *SweegoApi) shouldRetry(attempt int, method string, statusCode int) bool {
+	if attempt >= api.retry.MaxRetries {
+		return false
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case 0, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return method != "POST"
+	default:
+		return false
+	}
+}
+
+// waitForRetry waits before the next attempt. It returns an error if the context is cancelled meanwhile.
+func (api *SweegoApi) waitForRetry(attempt int, response *http.Response) error {
+	delay := api.retry.Backoff << attempt
+	if response != nil {
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+			delay = min(time.Duration(max(seconds, 0))*time.Second, maxRateLimitWait)
+		}
+	}
+
+	api.logger.Info(fmt.Sprintf("Retrying request in %s (retry %d of %d)", delay, attempt+1, api.retry.MaxRetries))
+	return api.sleep(delay)
+}
+
+// sleep waits for the given duration. It returns the error of the context if it is cancelled meanwhile.
+func (api *SweegoApi) sleep(delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-api.ctx.Done():
+		return api.ctx.Err()
+	}
+}
+
+// Do sends a request to an endpoint relative to the base URL (e.g. "clients/123/suppressions/import") that
+// is not covered by the client, using its authentication, retries and rate limit. body may be nil for
+// requests without body, decoder may be nil in order to ignore the response body. Responses with a status
+// code >= 300 are returned as *SweegoHttpError.
+func (api *SweegoApi) Do(method string, endpoint string, body SweegoRequestEncoder, decoder SweegoResponseDecoder) error {
+	api.logger.Debug(fmt.Sprintf("Do(%#v, %#v)", method, endpoint))
+
+	return api.executeRequest(method, endpoint, body, decoder)
+}
+
+func (api *SweegoApi) executeJsonRequest(method string, endpoint string, body interface{}, responseData interface{}) error {
+	return api.executeRequest(method, endpoint, SweegoJsonEncoder{body}, SweegoJsonDecoder{responseData})
+}
+
+func (api *SweegoApi) executePlainRequest(method string, endpoint string, responseData interface{}) error {
+	return api.executeRequest(method, endpoint, nil, SweegoJsonDecoder{responseData})
+}
+
+func (api *SweegoApi) executeGetRequest(endpoint string, responseData interface{}) error {
+	return api.executeRequest("GET", endpoint, nil, SweegoJsonDecoder{responseData})
+}
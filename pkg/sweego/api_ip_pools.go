@@ -0,0 +1,109 @@
+package sweego
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SweegoDedicatedIp is a dedicated IP of the client including its warm-up status.
+type SweegoDedicatedIp struct {
+	Ip             string `json:"ip"`
+	ReverseDns     string `json:"reverse_dns"`
+	IpPoolUuid     string `json:"ip_pool_uuid"`
+	WarmupStatus   string `json:"warmup_status"`
+	WarmupProgress int64  `json:"warmup_progress"`
+}
+
+// SweegoIpPool is a named pool of dedicated IPs.
+type SweegoIpPool struct {
+	Uuid   string   `json:"uuid"`
+	Name   string   `json:"name"`
+	IpList []string `json:"ip_list"`
+}
+
+// SweegoIpPoolChangeRequest contains the writable properties of an IP pool.
+type SweegoIpPoolChangeRequest struct {
+	Name   string   `json:"name"`
+	IpList []string `json:"ip_list"`
+}
+
+// SweegoDomainIpPoolAssignment is the IP pool a domain sends through.
+type SweegoDomainIpPoolAssignment struct {
+	IpPoolUuid string `json:"ip_pool_uuid"`
+}
+
+func (api *SweegoApi) ListDedicatedIps(clientId string) ([]SweegoDedicatedIp, error) {
+	api.logger.Debug(fmt.Sprintf("ListDedicatedIps(%#v)", clientId))
+
+	return fetchAllPages[SweegoDedicatedIp](api, fmt.Sprintf("clients/%s/dedicated-ips", api.resolveClientId(clientId)), nil)
+}
+
+// IterateDedicatedIps returns an iterator over all dedicated IPs of the client. Further pages are requested while iterating.
+func (api *SweegoApi) IterateDedicatedIps(clientId string) iter.Seq2[SweegoDedicatedIp, error] {
+	api.logger.Debug(fmt.Sprintf("IterateDedicatedIps(%#v)", clientId))
+
+	return paginate[SweegoDedicatedIp](api, fmt.Sprintf("clients/%s/dedicated-ips", api.resolveClientId(clientId)), nil)
+}
+
+func (api *SweegoApi) ListIpPools(clientId string) ([]SweegoIpPool, error) {
+	api.logger.Debug(fmt.Sprintf("ListIpPools(%#v)", clientId))
+
+	return fetchAllPages[SweegoIpPool](api, fmt.Sprintf("clients/%s/ip-pools", api.resolveClientId(clientId)), nil)
+}
+
+// IterateIpPools returns an iterator over all IP pools of the client. Further pages are requested while iterating.
+func (api *SweegoApi) IterateIpPools(clientId string) iter.Seq2[SweegoIpPool, error] {
+	api.logger.Debug(fmt.Sprintf("IterateIpPools(%#v)", clientId))
+
+	return paginate[SweegoIpPool](api, fmt.Sprintf("clients/%s/ip-pools", api.resolveClientId(clientId)), nil)
+}
+
+func (api *SweegoApi) GetIpPool(clientId string, uuid string) (SweegoIpPool, error) {
+	api.logger.Debug(fmt.Sprintf("GetIpPool(%#v, %#v)", clientId, uuid))
+
+	var response SweegoIpPool
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/ip-pools/%s", api.resolveClientId(clientId), uuid), &response)
+	return response, err
+}
+
+func (api *SweegoApi) CreateIpPool(clientId string, pool SweegoIpPoolChangeRequest) (SweegoIpPool, error) {
+	api.logger.Debug(fmt.Sprintf("CreateIpPool(%#v, %#v)", clientId, pool))
+
+	var response SweegoIpPool
+	err := api.executeJsonRequest("POST", fmt.Sprintf("clients/%s/ip-pools", api.resolveClientId(clientId)), pool, &response)
+	return response, err
+}
+
+func (api *SweegoApi) UpdateIpPool(clientId string, uuid string, pool SweegoIpPoolChangeRequest) (SweegoIpPool, error) {
+	api.logger.Debug(fmt.Sprintf("UpdateIpPool(%#v, %#v, %#v)", clientId, uuid, pool))
+
+	var response SweegoIpPool
+	err := api.executeJsonRequest("PUT", fmt.Sprintf("clients/%s/ip-pools/%s", api.resolveClientId(clientId), uuid), pool, &response)
+	return response, err
+}
+
+func (api *SweegoApi) DeleteIpPool(clientId string, uuid string) error {
+	api.logger.Debug(fmt.Sprintf("DeleteIpPool(%#v, %#v)", clientId, uuid))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/ip-pools/%s", api.resolveClientId(clientId), uuid), nil)
+}
+
+func (api *SweegoApi) GetDomainIpPool(clientId string, domainUuid string) (SweegoDomainIpPoolAssignment, error) {
+	api.logger.Debug(fmt.Sprintf("GetDomainIpPool(%#v, %#v)", clientId, domainUuid))
+
+	var response SweegoDomainIpPoolAssignment
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/domains/%s/ip-pool", api.resolveClientId(clientId), domainUuid), &response)
+	return response, err
+}
+
+func (api *SweegoApi) AssignDomainIpPool(clientId string, domainUuid string, assignment SweegoDomainIpPoolAssignment) error {
+	api.logger.Debug(fmt.Sprintf("AssignDomainIpPool(%#v, %#v, %#v)", clientId, domainUuid, assignment))
+
+	return api.executeJsonRequest("PUT", fmt.Sprintf("clients/%s/domains/%s/ip-pool", api.resolveClientId(clientId), domainUuid), assignment, nil)
+}
+
+func (api *SweegoApi) UnassignDomainIpPool(clientId string, domainUuid string) error {
+	api.logger.Debug(fmt.Sprintf("UnassignDomainIpPool(%#v, %#v)", clientId, domainUuid))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/domains/%s/ip-pool", api.resolveClientId(clientId), domainUuid), nil)
+}
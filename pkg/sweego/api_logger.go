@@ -5,12 +5,14 @@ import (
 	"log"
 )
 
+// SweegoApiLogger receives the log messages of SweegoApi, including all requests and responses on debug level.
 type SweegoApiLogger interface {
 	Info(message string)
 	Error(message string)
 	Debug(message string)
 }
 
+// GolangLogger logs to the standard library logger. It is used if no other logger is configured.
 type GolangLogger struct{}
 
 func (l GolangLogger) Info(message string) {
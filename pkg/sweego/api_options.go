@@ -0,0 +1,66 @@
+package sweego
+
+import (
+	"net/http"
+	"time"
+)
+
+// SweegoApiOption changes the configuration of a SweegoApi created using NewSweegoApi.
+type SweegoApiOption func(api *SweegoApi)
+
+// SweegoRetryPolicy describes how failed requests are retried. Requests are retried if the API could not
+// be reached, responds with 429 Too Many Requests or with 502, 503 or 504. Non-idempotent requests (POST)
+// are only retried after 429 Too Many Requests, as the API did not process them in that case.
+type SweegoRetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. 0 disables retries.
+	MaxRetries int
+	// Backoff is the delay before the first retry. It doubles with every further retry, unless the API
+	// tells how long to wait using the Retry-After header.
+	Backoff time.Duration
+}
+
+// WithBaseUrl sends requests to a different URL than DefaultBaseUrl (e.g. a local stand-in).
+func WithBaseUrl(baseUrl string) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.baseUrl = baseUrl
+	}
+}
+
+// WithHttpClient sends requests using the given HTTP client, e.g. in order to configure timeouts or proxies.
+func WithHttpClient(httpClient *http.Client) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.httpClient = httpClient
+	}
+}
+
+// WithLogger logs to the given logger instead of the standard library logger.
+func WithLogger(logger SweegoApiLogger) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.logger = logger
+	}
+}
+
+// WithUserAgent sends the given User-Agent instead of DefaultUserAgent.
+func WithUserAgent(userAgent string) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.userAgent = userAgent
+	}
+}
+
+// WithRetry retries failed requests up to maxRetries times, waiting backoff before the first retry.
+func WithRetry(maxRetries int, backoff time.Duration) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.retry = SweegoRetryPolicy{MaxRetries: maxRetries, Backoff: backoff}
+	}
+}
+
+// WithRateLimit limits the requests of the API and all copies of it (see SweegoApi.WithLogger) to
+// requestsPerSecond, allowing bursts of up to burst requests. 0 disables the limit.
+//
+// Independent of the configured limit, requests are slowed down if the API reports that the remaining
+// quota of its own rate limit is low.
+func WithRateLimit(requestsPerSecond float64, burst int) SweegoApiOption {
+	return func(api *SweegoApi) {
+		api.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
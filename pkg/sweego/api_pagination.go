@@ -0,0 +1,112 @@
+package sweego
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the number of items requested per page from paginated list endpoints.
+const DefaultPageSize = 100
+
+// SweegoPage is a single page returned by a paginated list endpoint. Endpoints either paginate using
+// page and limit or, if NextCursor is set, using cursors.
+type SweegoPage[T any] struct {
+	Items      []T    `json:"items"`
+	Total      int    `json:"total"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// paginate returns an iterator over all items of the given list endpoint, requesting further pages while
+// iterating. The query parameters passed will be sent with every page request.
+//
+// Endpoints that are not paginated (yet) and respond with a bare JSON array are supported as well, in order
+// for callers to not depend on which endpoints are paginated. Iteration stops after the first error.
+func paginate[T any](api *SweegoApi, endpoint string, query url.Values) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageQuery := url.Values{}
+		for key, values := range query {
+			pageQuery[key] = values
+		}
+		pageQuery.Set("limit", strconv.Itoa(DefaultPageSize))
+		pageQuery.Set("page", "1")
+
+		var zero T
+		count := 0
+		cursorBased := false
+		for page := 1; ; page++ {
+			var response json.RawMessage
+			err := api.executeGetRequest(fmt.Sprintf("%s?%s", endpoint, pageQuery.Encode()), &response)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			var current SweegoPage[T]
+			bareArray := len(response) > 0 && response[0] == '['
+			if bareArray {
+				err = json.Unmarshal(response, &current.Items)
+			} else {
+				err = json.Unmarshal(response, &current)
+			}
+			if err != nil {
+				yield(zero, fmt.Errorf("Error reading page %d of %s: %s", page, endpoint, err))
+				return
+			}
+
+			for _, item := range current.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			count += len(current.Items)
+
+			switch {
+			case bareArray:
+				return
+			case current.NextCursor != "":
+				cursorBased = true
+				pageQuery.Del("page")
+				pageQuery.Set("cursor", current.NextCursor)
+			case cursorBased || lastPage(current, count):
+				return
+			default:
+				pageQuery.Set("page", strconv.Itoa(page+1))
+			}
+		}
+	}
+}
+
+// lastPage decides whether a page based listing is complete after the given page, count being the number of
+// items received so far. The total reported by the server is preferred. Otherwise, a page with less items
+// than the limit reported by the server (which may be lower than the requested limit) is the last one.
+func lastPage[T any](current SweegoPage[T], count int) bool {
+	if len(current.Items) == 0 {
+		return true
+	}
+	if current.Total > 0 {
+		return count >= current.Total
+	}
+	limit := current.Limit
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	return len(current.Items) < limit
+}
+
+// fetchAllPages requests all pages of the given endpoint and returns the concatenated items.
+// The query parameters passed will be sent with every page request.
+func fetchAllPages[T any](api *SweegoApi, endpoint string, query url.Values) ([]T, error) {
+	items := []T{}
+	for item, err := range paginate[T](api, endpoint, query) {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,125 @@
+package sweego
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// pagedHandler serves count items as pages of at most pageSize items, ignoring larger requested limits like
+// an API with a maximum page size. Unless reportTotal is set, the total is omitted.
+func pagedHandler(count int, pageSize int, reportLimit bool, reportTotal bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		limit = min(limit, pageSize)
+
+		items := []int{}
+		for i := (page - 1) * limit; i < min(page*limit, count); i++ {
+			items = append(items, i)
+		}
+		response := map[string]any{"items": items, "page": page}
+		if reportLimit {
+			response["limit"] = limit
+		}
+		if reportTotal {
+			response["total"] = count
+		}
+
+		body, _ := json.Marshal(response)
+		respond(http.StatusOK, string(body))(w, r)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		items    int
+		requests int
+	}{
+		{"single page", pagedHandler(5, 100, true, false), 5, 1},
+		{"empty", pagedHandler(0, 100, true, false), 0, 1},
+		{"full pages", pagedHandler(250, 100, true, false), 250, 3},
+		{"exact multiple of the page size", pagedHandler(200, 100, true, false), 200, 3},
+		{"server limit below the requested limit", pagedHandler(45, 20, true, false), 45, 3},
+		{"server limit and total", pagedHandler(45, 20, true, true), 45, 3},
+		{"total without limit", pagedHandler(45, 20, false, true), 45, 3},
+		{"total of exact multiple", pagedHandler(40, 20, true, true), 40, 2},
+		{"bare array", respond(http.StatusOK, `[0, 1, 2]`), 3, 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api, server, _ := newTestApi(t, test.handler)
+
+			items, err := fetchAllPages[int](api, "items", url.Values{"reason": {"bounce"}})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			expected := []int{}
+			for i := range test.items {
+				expected = append(expected, i)
+			}
+			if !reflect.DeepEqual(items, expected) {
+				t.Errorf("expected %d items, got %v", test.items, items)
+			}
+
+			requests := server.Requests()
+			if len(requests) != test.requests {
+				t.Errorf("expected %d requests, got %d", test.requests, len(requests))
+			}
+			for i, request := range requests {
+				query, _ := url.ParseQuery(request.Query)
+				if query.Get("reason") != "bounce" || query.Get("limit") != strconv.Itoa(DefaultPageSize) || query.Get("page") != strconv.Itoa(i+1) {
+					t.Errorf("unexpected query of request %d: %s", i, request.Query)
+				}
+			}
+		})
+	}
+}
+
+func TestPaginateCursor(t *testing.T) {
+	api, server, _ := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			respond(http.StatusOK, `{"items":[0,1],"next_cursor":"abc"}`)(w, r)
+		case "abc":
+			respond(http.StatusOK, `{"items":[2],"next_cursor":""}`)(w, r)
+		default:
+			respond(http.StatusBadRequest, `{"detail":"invalid cursor"}`)(w, r)
+		}
+	})
+
+	items, err := fetchAllPages[int](api, "items", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(items, []int{0, 1, 2}) {
+		t.Errorf("unexpected items %v", items)
+	}
+	if requests := server.Requests(); len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestPaginateStopsAfterError(t *testing.T) {
+	api, _, _ := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			respond(http.StatusInternalServerError, `{"detail":"error"}`)(w, r)
+			return
+		}
+		pagedHandler(150, 100, true, false)(w, r)
+	})
+
+	items, err := fetchAllPages[int](api, "items", nil)
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+	if len(items) != 100 {
+		t.Errorf("expected the items of the first page, got %d", len(items))
+	}
+}
@@ -0,0 +1,126 @@
+package sweego
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// lowQuotaRatio is the share of the rate limit quota below which requests are slowed down, in order to
+// spread the remaining requests over the rest of the rate limit window instead of running into 429s.
+const lowQuotaRatio = 0.1
+
+// maxRateLimitWait caps the rate limit window and the Retry-After delay announced by the API, so a bogus
+// header does not block requests for days.
+const maxRateLimitWait = time.Hour
+
+// rateLimiter is a token bucket limiting the requests of a SweegoApi and all copies of it (see WithLogger).
+// Additionally, it slows down if the rate limit headers of responses report a low remaining quota.
+type rateLimiter struct {
+	mutex sync.Mutex
+
+	// requestsPerSecond is the configured rate. 0 disables the token bucket, adaptive slowdown still applies.
+	requestsPerSecond float64
+	burst             float64
+	tokens            float64
+	lastRefill        time.Time
+
+	// slowdownRate replaces requestsPerSecond until slowdownUntil, if the remaining quota is low.
+	slowdownRate  float64
+	slowdownUntil time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+		lastRefill:        time.Now(),
+	}
+}
+
+// rate returns the rate currently in effect, 0 if requests are not limited.
+func (limiter *rateLimiter) rate(now time.Time) float64 {
+	if now.Before(limiter.slowdownUntil) && (limiter.requestsPerSecond == 0 || limiter.slowdownRate < limiter.requestsPerSecond) {
+		return limiter.slowdownRate
+	}
+	return limiter.requestsPerSecond
+}
+
+// reserve takes a token and returns how long the caller has to wait before sending the request.
+func (limiter *rateLimiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	rate := limiter.rate(now)
+	limiter.refill(now, rate)
+	if rate == 0 {
+		return 0
+	}
+
+	// Tokens may become negative: Every waiting caller is a debt that is paid off by future refills
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-limiter.tokens / rate * float64(time.Second))
+}
+
+// refill adds the tokens accumulated since the last refill at the given rate. Without limit, the
+// bucket is always full.
+func (limiter *rateLimiter) refill(now time.Time, rate float64) {
+	if rate == 0 {
+		limiter.tokens = limiter.burst
+	} else {
+		limiter.tokens = min(limiter.tokens+now.Sub(limiter.lastRefill).Seconds()*rate, limiter.burst)
+	}
+	limiter.lastRefill = now
+}
+
+// observe adapts the rate to the rate limit headers of a response. It returns the rate that applies
+// until the end of the rate limit window if requests are slowed down, 0 otherwise.
+func (limiter *rateLimiter) observe(header http.Header) float64 {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return 0
+	}
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil || float64(remaining) > float64(limit)*lowQuotaRatio {
+		return 0
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	limiter.refill(now, limiter.rate(now))
+	window := resetWindow(header.Get("X-RateLimit-Reset"), now)
+	limiter.slowdownUntil = now.Add(window)
+	// Spread the remaining requests over the window. With no requests left, the next request is
+	// sent at the end of the window.
+	limiter.slowdownRate = float64(max(remaining, 1)) / window.Seconds()
+	limiter.tokens = min(limiter.tokens, float64(min(remaining, 1)))
+	return limiter.slowdownRate
+}
+
+// resetWindow returns the time until the rate limit quota is reset according to the X-RateLimit-Reset header,
+// which contains either the number of seconds until the reset or the unix timestamp of the reset. Values after
+// a year ago are considered timestamps, as no rate limit window lasts that long. The window is at least a
+// second and at most maxRateLimitWait.
+func resetWindow(value string, now time.Time) time.Duration {
+	reset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Second
+	}
+
+	window := time.Duration(reset) * time.Second
+	if reset > now.AddDate(-1, 0, 0).Unix() {
+		window = time.Unix(reset, 0).Sub(now)
+	}
+	return min(max(window, time.Second), maxRateLimitWait)
+}
@@ -0,0 +1,93 @@
+package sweego
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResetWindow(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{"seconds", "30", 30 * time.Second},
+		{"missing", "", time.Second},
+		{"invalid", "soon", time.Second},
+		{"zero", "0", time.Second},
+		{"negative", "-5", time.Second},
+		{"seconds above the cap", "86400", maxRateLimitWait},
+		{"unix timestamp", strconv.FormatInt(now.Add(45*time.Second).Unix(), 10), 45 * time.Second},
+		{"unix timestamp in the past", strconv.FormatInt(now.Add(-time.Minute).Unix(), 10), time.Second},
+		{"unix timestamp above the cap", strconv.FormatInt(now.Add(48*time.Hour).Unix(), 10), maxRateLimitWait},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := resetWindow(test.value, now); actual != test.expected {
+				t.Errorf("resetWindow(%#v) = %s, expected %s", test.value, actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestObserveEpochReset(t *testing.T) {
+	limiter := newRateLimiter(0, 1)
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "100")
+	header.Set("X-RateLimit-Remaining", "5")
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(10*time.Second).Unix(), 10))
+
+	// 5 requests in about 10 seconds, instead of spreading them over 56 years
+	if rate := limiter.observe(header); rate < 0.4 || rate > 0.6 {
+		t.Errorf("expected a rate of about 0.5 requests per second, got %f", rate)
+	}
+}
+
+func TestRetryCancelledByContext(t *testing.T) {
+	api, server, _ := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		respond(http.StatusTooManyRequests, `{"detail":"Too Many Requests"}`)(w, r)
+	}, WithRetry(3, time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := api.WithContext(ctx).ListDomains("")
+	if err == nil || !strings.Contains(err.Error(), "Cancelled while waiting for retry") {
+		t.Errorf("expected the retry to be cancelled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be cancelled immediately, took %s", elapsed)
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("expected 1 request, got %d", len(requests))
+	}
+}
+
+func TestRateLimitCancelledByContext(t *testing.T) {
+	api, server, _ := newTestApi(t, respond(http.StatusOK, `[]`), WithRateLimit(0.1, 1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	api = api.WithContext(ctx)
+
+	if err := api.Do("GET", "clients/test-client/domains", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// The second request has to wait 10 seconds for a token
+	err := api.Do("GET", "clients/test-client/domains", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "Cancelled while waiting for the rate limit") {
+		t.Errorf("expected the request to be cancelled, got %v", err)
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("expected 1 request, got %d", len(requests))
+	}
+}
@@ -0,0 +1,109 @@
+package sweego
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// SweegoAddress is an E-Mail address with an optional display name.
+type SweegoAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// SweegoAttachment is a file attached to an E-Mail. Content is the base64 encoded file content,
+// use NewSweegoAttachment in order to create attachments from raw data.
+type SweegoAttachment struct {
+	Filename string `json:"filename"`
+	Content  string `json:"content"`
+}
+
+// NewSweegoAttachment creates an attachment from the raw file content.
+func NewSweegoAttachment(filename string, data []byte) SweegoAttachment {
+	return SweegoAttachment{
+		Filename: filename,
+		Content:  base64.StdEncoding.EncodeToString(data),
+	}
+}
+
+// SweegoSendRequest is a transactional E-Mail. Channel and Provider default to email and sweego.
+type SweegoSendRequest struct {
+	Channel    string          `json:"channel"`
+	Provider   string          `json:"provider"`
+	Recipients []SweegoAddress `json:"recipients"`
+	Cc         []SweegoAddress `json:"cc,omitempty"`
+	Bcc        []SweegoAddress `json:"bcc,omitempty"`
+	From       SweegoAddress   `json:"from"`
+	ReplyTo    *SweegoAddress  `json:"reply-to,omitempty"`
+	Subject    string          `json:"subject,omitempty"`
+
+	// Either MessageHtml and/or MessageTxt or TemplateId must be set.
+	MessageHtml string `json:"message-html,omitempty"`
+	MessageTxt  string `json:"message-txt,omitempty"`
+	TemplateId  string `json:"template-id,omitempty"`
+	// Variables that are replaced in the template
+	Variables map[string]string `json:"variables,omitempty"`
+
+	Headers     map[string]string  `json:"headers,omitempty"`
+	Attachments []SweegoAttachment `json:"attachments,omitempty"`
+
+	// Campaign metadata, used for statistics and filtering in sweego
+	CampaignType string   `json:"campaign-type,omitempty"`
+	CampaignId   string   `json:"campaign-id,omitempty"`
+	CampaignTags []string `json:"campaign-tags,omitempty"`
+}
+
+// SweegoSendResponse identifies the sent E-Mails.
+type SweegoSendResponse struct {
+	TransactionId string `json:"transaction_id"`
+	// MessageIds of the sent E-Mails, keyed by recipient address
+	MessageIds map[string]string `json:"swg_uids"`
+}
+
+// validate catches requests sweego would reject before they are sent.
+func (message SweegoSendRequest) validate() error {
+	if len(message.Recipients) == 0 {
+		return errors.New("at least one recipient is required")
+	}
+	if message.From.Email == "" {
+		return errors.New("from address is required")
+	}
+	if message.TemplateId == "" && message.MessageHtml == "" && message.MessageTxt == "" {
+		return errors.New("either a message (HTML or text) or a template ID is required")
+	}
+	if message.TemplateId == "" && message.Subject == "" {
+		return errors.New("subject is required if no template is used")
+	}
+	for _, attachment := range message.Attachments {
+		if _, err := base64.StdEncoding.DecodeString(attachment.Content); err != nil {
+			return fmt.Errorf("content of attachment %#v is not base64 encoded: %s", attachment.Filename, err)
+		}
+	}
+	return nil
+}
+
+// Send sends a transactional E-Mail and returns the IDs of the sent messages. The sending domain
+// is determined by the From address, the client is determined by the API key.
+func (api *SweegoApi) Send(message SweegoSendRequest) (SweegoSendResponse, error) {
+	// Only a summary is logged, as the message contains personal data and possibly large attachments.
+	api.logger.Debug(fmt.Sprintf(
+		"Send(recipients: %d, cc: %d, bcc: %d, subject: %#v, template: %#v, attachments: %d)",
+		len(message.Recipients), len(message.Cc), len(message.Bcc), message.Subject, message.TemplateId, len(message.Attachments),
+	))
+
+	if message.Channel == "" {
+		message.Channel = "email"
+	}
+	if message.Provider == "" {
+		message.Provider = "sweego"
+	}
+
+	if err := message.validate(); err != nil {
+		return SweegoSendResponse{}, fmt.Errorf("Invalid message: %s", err)
+	}
+
+	var response SweegoSendResponse
+	err := api.executeJsonRequest("POST", "send", message, &response)
+	return response, err
+}
@@ -0,0 +1,128 @@
+package sweego
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testMessage() SweegoSendRequest {
+	return SweegoSendRequest{
+		From:        SweegoAddress{Email: "noreply@example.com", Name: "Example"},
+		Recipients:  []SweegoAddress{{Email: "customer@example.org"}},
+		Subject:     "Welcome",
+		MessageTxt:  "Hello customer",
+		Attachments: []SweegoAttachment{NewSweegoAttachment("terms.txt", []byte("secret terms"))},
+	}
+}
+
+func TestSend(t *testing.T) {
+	api, server, logger := newTestApi(t, respond(http.StatusOK, `{"transaction_id":"tx-1","swg_uids":{"customer@example.org":"uid-1"}}`))
+
+	response, err := api.Send(testMessage())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if response.TransactionId != "tx-1" || response.MessageIds["customer@example.org"] != "uid-1" {
+		t.Errorf("unexpected response %#v", response)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(requests))
+	}
+	if requests[0].Method != "POST" || requests[0].Path != "/send" || requests[0].ContentType != "application/json" {
+		t.Errorf("unexpected request %#v", requests[0])
+	}
+	var sent SweegoSendRequest
+	if err := json.Unmarshal(requests[0].Body, &sent); err != nil {
+		t.Fatalf("cannot parse request body: %s", err)
+	}
+	if sent.Channel != "email" || sent.Provider != "sweego" || sent.Subject != "Welcome" || len(sent.Attachments) != 1 {
+		t.Errorf("unexpected request body %#v", sent)
+	}
+
+	for _, message := range logger.messages {
+		for _, content := range []string{`Email:"customer@example.org"`, `"email":"customer@example.org"`, "Hello customer", sent.Attachments[0].Content} {
+			if strings.Contains(message, content) {
+				t.Errorf("expected %#v not to be logged, got %#v", content, message)
+			}
+		}
+	}
+	if !strings.Contains(logger.messages[0], `Send(recipients: 1, cc: 0, bcc: 0, subject: "Welcome"`) {
+		t.Errorf("expected a summary to be logged, got %#v", logger.messages[0])
+	}
+}
+
+func TestSendValidationError(t *testing.T) {
+	tests := map[string]func(*SweegoSendRequest){
+		"no recipients":         func(message *SweegoSendRequest) { message.Recipients = nil },
+		"no from address":       func(message *SweegoSendRequest) { message.From = SweegoAddress{} },
+		"no message":            func(message *SweegoSendRequest) { message.MessageTxt = "" },
+		"no subject":            func(message *SweegoSendRequest) { message.Subject = "" },
+		"attachment not base64": func(message *SweegoSendRequest) { message.Attachments[0].Content = "not base64!" },
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			api, server, _ := newTestApi(t, respond(http.StatusOK, `{}`))
+
+			message := testMessage()
+			modify(&message)
+			_, err := api.Send(message)
+			if err == nil || !strings.HasPrefix(err.Error(), "Invalid message") {
+				t.Errorf("expected a validation error, got %v", err)
+			}
+			if len(server.Requests()) != 0 {
+				t.Errorf("expected no request to be sent")
+			}
+		})
+	}
+}
+
+func TestSendRejectedByApi(t *testing.T) {
+	api, _, _ := newTestApi(t, respond(http.StatusUnprocessableEntity, `{"detail":"invalid sender"}`))
+
+	_, err := api.Send(testMessage())
+	if err == nil || !strings.Contains(err.Error(), "422") || !strings.Contains(err.Error(), "invalid sender") {
+		t.Errorf("expected the API error, got %v", err)
+	}
+}
+
+func TestSendRetriesTooManyRequests(t *testing.T) {
+	attempts := 0
+	api, server, _ := newTestApi(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			respond(http.StatusTooManyRequests, `{"detail":"Too Many Requests"}`)(w, r)
+			return
+		}
+		respond(http.StatusOK, `{"transaction_id":"tx-2"}`)(w, r)
+	}, WithRetry(3, time.Millisecond))
+
+	response, err := api.Send(testMessage())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if response.TransactionId != "tx-2" {
+		t.Errorf("unexpected response %#v", response)
+	}
+	if requests := server.Requests(); len(requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(requests))
+	}
+}
+
+func TestSendGivesUpAfterMaxRetries(t *testing.T) {
+	api, server, _ := newTestApi(t, respond(http.StatusTooManyRequests, `{"detail":"Too Many Requests"}`), WithRetry(2, time.Millisecond))
+
+	_, err := api.Send(testMessage())
+	if err == nil || !strings.Contains(err.Error(), "429") {
+		t.Errorf("expected a 429 error, got %v", err)
+	}
+	if requests := server.Requests(); len(requests) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(requests))
+	}
+}
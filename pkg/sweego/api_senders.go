@@ -0,0 +1,67 @@
+package sweego
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SweegoSender is a sender identity (From address and name) of a domain.
+type SweegoSender struct {
+	Uuid       string `json:"uuid"`
+	DomainUuid string `json:"domain_uuid"`
+	Email      string `json:"email"`
+	Name       string `json:"name"`
+	ReplyTo    string `json:"reply_to"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+// SweegoSenderChangeRequest contains the writable properties of a sender.
+type SweegoSenderChangeRequest struct {
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	ReplyTo   string `json:"reply_to,omitempty"`
+	IsDefault bool   `json:"is_default"`
+}
+
+func (api *SweegoApi) ListSenders(clientId string, domainUuid string) ([]SweegoSender, error) {
+	api.logger.Debug(fmt.Sprintf("ListSenders(%#v, %#v)", clientId, domainUuid))
+
+	return fetchAllPages[SweegoSender](api, fmt.Sprintf("clients/%s/domains/%s/senders", api.resolveClientId(clientId), domainUuid), nil)
+}
+
+// IterateSenders returns an iterator over all senders of the domain. Further pages are requested while iterating.
+func (api *SweegoApi) IterateSenders(clientId string, domainUuid string) iter.Seq2[SweegoSender, error] {
+	api.logger.Debug(fmt.Sprintf("IterateSenders(%#v, %#v)", clientId, domainUuid))
+
+	return paginate[SweegoSender](api, fmt.Sprintf("clients/%s/domains/%s/senders", api.resolveClientId(clientId), domainUuid), nil)
+}
+
+func (api *SweegoApi) GetSender(clientId string, domainUuid string, uuid string) (SweegoSender, error) {
+	api.logger.Debug(fmt.Sprintf("GetSender(%#v, %#v, %#v)", clientId, domainUuid, uuid))
+
+	var response SweegoSender
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/domains/%s/senders/%s", api.resolveClientId(clientId), domainUuid, uuid), &response)
+	return response, err
+}
+
+func (api *SweegoApi) CreateSender(clientId string, domainUuid string, sender SweegoSenderChangeRequest) (SweegoSender, error) {
+	api.logger.Debug(fmt.Sprintf("CreateSender(%#v, %#v, %#v)", clientId, domainUuid, sender))
+
+	var response SweegoSender
+	err := api.executeJsonRequest("POST", fmt.Sprintf("clients/%s/domains/%s/senders", api.resolveClientId(clientId), domainUuid), sender, &response)
+	return response, err
+}
+
+func (api *SweegoApi) UpdateSender(clientId string, domainUuid string, uuid string, sender SweegoSenderChangeRequest) (SweegoSender, error) {
+	api.logger.Debug(fmt.Sprintf("UpdateSender(%#v, %#v, %#v, %#v)", clientId, domainUuid, uuid, sender))
+
+	var response SweegoSender
+	err := api.executeJsonRequest("PUT", fmt.Sprintf("clients/%s/domains/%s/senders/%s", api.resolveClientId(clientId), domainUuid, uuid), sender, &response)
+	return response, err
+}
+
+func (api *SweegoApi) DeleteSender(clientId string, domainUuid string, uuid string) error {
+	api.logger.Debug(fmt.Sprintf("DeleteSender(%#v, %#v, %#v)", clientId, domainUuid, uuid))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/domains/%s/senders/%s", api.resolveClientId(clientId), domainUuid, uuid), nil)
+}
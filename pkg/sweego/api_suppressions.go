@@ -0,0 +1,84 @@
+package sweego
+
+import (
+	"fmt"
+	"iter"
+	"net/url"
+)
+
+// SweegoSuppression is an entry of the suppression list. E-Mails to suppressed addresses are not sent.
+type SweegoSuppression struct {
+	Uuid         string `json:"uuid"`
+	Email        string `json:"email"`
+	Reason       string `json:"reason"`
+	Domain       string `json:"domain"`
+	CreationDate string `json:"creation_dt"`
+}
+
+// SweegoSuppressionCreateRequest adds an address to the suppression list.
+type SweegoSuppressionCreateRequest struct {
+	Email  string `json:"email"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// SweegoSuppressionFilter restricts the entries returned by ListSuppressions.
+// Empty values are not sent to the API. Dates are expected in RFC 3339 format.
+type SweegoSuppressionFilter struct {
+	Reason        string
+	Domain        string
+	CreatedAfter  string
+	CreatedBefore string
+}
+
+func (filter SweegoSuppressionFilter) query() url.Values {
+	query := url.Values{}
+	if filter.Reason != "" {
+		query.Set("reason", filter.Reason)
+	}
+	if filter.Domain != "" {
+		query.Set("domain", filter.Domain)
+	}
+	if filter.CreatedAfter != "" {
+		query.Set("created_after", filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != "" {
+		query.Set("created_before", filter.CreatedBefore)
+	}
+	return query
+}
+
+func (api *SweegoApi) ListSuppressions(clientId string, filter SweegoSuppressionFilter) ([]SweegoSuppression, error) {
+	api.logger.Debug(fmt.Sprintf("ListSuppressions(%#v, %#v)", clientId, filter))
+
+	return fetchAllPages[SweegoSuppression](api, fmt.Sprintf("clients/%s/suppressions", api.resolveClientId(clientId)), filter.query())
+}
+
+// IterateSuppressions returns an iterator over all entries matching the filter. Further pages are requested
+// while iterating, so large suppression lists can be processed without loading them at once.
+func (api *SweegoApi) IterateSuppressions(clientId string, filter SweegoSuppressionFilter) iter.Seq2[SweegoSuppression, error] {
+	api.logger.Debug(fmt.Sprintf("IterateSuppressions(%#v, %#v)", clientId, filter))
+
+	return paginate[SweegoSuppression](api, fmt.Sprintf("clients/%s/suppressions", api.resolveClientId(clientId)), filter.query())
+}
+
+func (api *SweegoApi) GetSuppression(clientId string, email string) (SweegoSuppression, error) {
+	api.logger.Debug(fmt.Sprintf("GetSuppression(%#v, %#v)", clientId, email))
+
+	var response SweegoSuppression
+	err := api.executeGetRequest(fmt.Sprintf("clients/%s/suppressions/%s", api.resolveClientId(clientId), url.PathEscape(email)), &response)
+	return response, err
+}
+
+func (api *SweegoApi) AddSuppression(clientId string, suppression SweegoSuppressionCreateRequest) (SweegoSuppression, error) {
+	api.logger.Debug(fmt.Sprintf("AddSuppression(%#v, %#v)", clientId, suppression))
+
+	var response SweegoSuppression
+	err := api.executeJsonRequest("POST", fmt.Sprintf("clients/%s/suppressions", api.resolveClientId(clientId)), suppression, &response)
+	return response, err
+}
+
+func (api *SweegoApi) DeleteSuppression(clientId string, email string) error {
+	api.logger.Debug(fmt.Sprintf("DeleteSuppression(%#v, %#v)", clientId, email))
+
+	return api.executePlainRequest("DELETE", fmt.Sprintf("clients/%s/suppressions/%s", api.resolveClientId(clientId), url.PathEscape(email)), nil)
+}
@@ -0,0 +1,80 @@
+package sweego
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// testLogger records all log messages, so tests can check what is logged.
+type testLogger struct {
+	mutex    sync.Mutex
+	messages []string
+}
+
+func (logger *testLogger) log(message string) {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.messages = append(logger.messages, message)
+}
+
+func (logger *testLogger) Info(message string)  { logger.log(message) }
+func (logger *testLogger) Error(message string) { logger.log(message) }
+func (logger *testLogger) Debug(message string) { logger.log(message) }
+
+// recordedRequest is a request received by the test server.
+type recordedRequest struct {
+	Method      string
+	Path        string
+	Query       string
+	ContentType string
+	Body        []byte
+}
+
+// testServer records the requests it receives and answers them using the handler.
+type testServer struct {
+	mutex    sync.Mutex
+	requests []recordedRequest
+}
+
+func (server *testServer) Requests() []recordedRequest {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]recordedRequest{}, server.requests...)
+}
+
+// newTestApi starts a local server answering requests using the handler and returns a client for it.
+func newTestApi(t *testing.T, handler http.HandlerFunc, options ...SweegoApiOption) (*SweegoApi, *testServer, *testLogger) {
+	t.Helper()
+
+	server := &testServer{}
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		server.mutex.Lock()
+		server.requests = append(server.requests, recordedRequest{
+			Method:      r.Method,
+			Path:        r.URL.Path,
+			Query:       r.URL.RawQuery,
+			ContentType: r.Header.Get("Content-Type"),
+			Body:        body,
+		})
+		server.mutex.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(httpServer.Close)
+
+	logger := &testLogger{}
+	options = append([]SweegoApiOption{WithBaseUrl(httpServer.URL), WithLogger(logger)}, options...)
+	return NewSweegoApi("test-key", "test-client", options...), server, logger
+}
+
+// respond returns a handler answering every request with the status code and JSON body.
+func respond(statusCode int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		_, _ = io.WriteString(w, body)
+	}
+}
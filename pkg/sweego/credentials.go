@@ -0,0 +1,72 @@
+package sweego
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Environment variables credentials are read from by LoadCredentials.
+const (
+	EnvApiKey          = "SWEEGO_API_KEY"
+	EnvClientId        = "SWEEGO_CLIENT_ID"
+	EnvBaseUrl         = "SWEEGO_BASE_URL"
+	EnvCredentialsFile = "SWEEGO_CREDENTIALS_FILE"
+)
+
+// SweegoCredentials are the settings required in order to connect to the API. They are stored as JSON
+// in the credentials file.
+type SweegoCredentials struct {
+	ApiKey   string `json:"api_key"`
+	ClientId string `json:"client_id"`
+	BaseUrl  string `json:"base_url,omitempty"`
+}
+
+// CredentialsFile returns the path of the credentials file: The value of SWEEGO_CREDENTIALS_FILE or
+// sweego/credentials.json in the user configuration directory (e.g. ~/.config on Linux).
+func CredentialsFile() string {
+	if path := os.Getenv(EnvCredentialsFile); path != "" {
+		return path
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(configDir, "sweego", "credentials.json")
+}
+
+// LoadCredentials completes the given credentials (e.g. from configuration or command line flags): Empty
+// values are taken from the environment variables and then from the credentials file. A missing
+// credentials file is not an error, the returned credentials may still be incomplete.
+func LoadCredentials(credentials SweegoCredentials) (SweegoCredentials, error) {
+	fallback := func(value *string, env string, fromFile string) {
+		if *value == "" {
+			*value = os.Getenv(env)
+		}
+		if *value == "" {
+			*value = fromFile
+		}
+	}
+
+	var file SweegoCredentials
+	path := CredentialsFile()
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return credentials, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(content, &file); err != nil {
+				return credentials, fmt.Errorf("Cannot parse credentials file %s: %s", path, err)
+			}
+		}
+	}
+
+	fallback(&credentials.ApiKey, EnvApiKey, file.ApiKey)
+	fallback(&credentials.ClientId, EnvClientId, file.ClientId)
+	fallback(&credentials.BaseUrl, EnvBaseUrl, file.BaseUrl)
+	return credentials, nil
+}
@@ -0,0 +1,15 @@
+// Package sweego is a client of the sweego API (https://www.sweego.io/).
+//
+// A client is created using NewSweegoApi and configured using options:
+//
+//	api := sweego.NewSweegoApi(apiKey, clientId,
+//		sweego.WithRetry(3, time.Second),
+//		sweego.WithUserAgent("my-service/1.0"),
+//	)
+//	domains, err := api.ListDomains("")
+//
+// The service areas of the API are described by interfaces (e.g. SweegoDomainsApi), which SweegoApi implements.
+// Webhooks and templates have no typed methods yet; their endpoints can be called using SweegoApi.Do.
+//
+// The package is versioned together with the provider, breaking changes are listed in its CHANGELOG.
+package sweego
@@ -0,0 +1,100 @@
+package sweego
+
+// The interfaces below describe the service areas of the sweego API. SweegoApi implements all of them;
+// code that only needs a single area should depend on its interface, so it can be tested without HTTP.
+
+var _ SweegoDomainsApi = &SweegoApi{}
+var _ SweegoSendersApi = &SweegoApi{}
+var _ SweegoSuppressionsApi = &SweegoApi{}
+var _ SweegoClientsApi = &SweegoApi{}
+var _ SweegoIpPoolsApi = &SweegoApi{}
+var _ SweegoSendApi = &SweegoApi{}
+
+// SweegoDomainsApi manages sending domains and their DNS records.
+type SweegoDomainsApi interface {
+	// ListDomains returns all domains of the client.
+	ListDomains(clientId string) ([]SweegoDomainListInformation, error)
+	// GetDomain returns the domain including the DNS records that need to be published.
+	GetDomain(clientId string, uuid string) (SweegoDomainDetails, error)
+	// CreateDomain adds a domain. Only the response of this call is guaranteed to contain the UUID.
+	CreateDomain(clientId string, domain string) (SweegoDomainDetails, error)
+	// DeleteDomain removes the domain.
+	DeleteDomain(clientId string, uuid string) error
+	// Check makes sweego verify the DNS records of the domain. Unlike GetDomain, this changes the remote
+	// state: The verification status of the domain and its records is updated.
+	Check(clientId string, uuid string) (SweegoDomainCheckResult, error)
+	// UpdateTracking configures open and click tracking of the domain (see SweegoTrackingChangeRequest).
+	UpdateTracking(clientId string, uuid string, tracking SweegoTrackingChangeRequest) error
+	// RotateDkim provisions a new DKIM selector and returns its record, which needs to be published.
+	RotateDkim(clientId string, uuid string) (SweegoDomainRecord, error)
+	// CompleteDkimRotation retires the old DKIM selector once the new one is verified.
+	CompleteDkimRotation(clientId string, uuid string) error
+}
+
+// SweegoSendersApi manages the sender identities of domains.
+type SweegoSendersApi interface {
+	// ListSenders returns all sender identities of the domain.
+	ListSenders(clientId string, domainUuid string) ([]SweegoSender, error)
+	// GetSender returns a single sender identity of the domain.
+	GetSender(clientId string, domainUuid string, uuid string) (SweegoSender, error)
+	// CreateSender allows the domain to send from the address of the sender.
+	CreateSender(clientId string, domainUuid string, sender SweegoSenderChangeRequest) (SweegoSender, error)
+	// UpdateSender replaces the settings of the sender identity.
+	UpdateSender(clientId string, domainUuid string, uuid string, sender SweegoSenderChangeRequest) (SweegoSender, error)
+	// DeleteSender removes the sender identity from the domain.
+	DeleteSender(clientId string, domainUuid string, uuid string) error
+}
+
+// SweegoSuppressionsApi manages the suppression list.
+type SweegoSuppressionsApi interface {
+	// ListSuppressions returns all entries matching the filter, fetching all pages.
+	ListSuppressions(clientId string, filter SweegoSuppressionFilter) ([]SweegoSuppression, error)
+	// GetSuppression returns the suppression list entry of the address.
+	GetSuppression(clientId string, email string) (SweegoSuppression, error)
+	// AddSuppression adds an address to the suppression list, so no E-Mails are sent to it.
+	AddSuppression(clientId string, suppression SweegoSuppressionCreateRequest) (SweegoSuppression, error)
+	// DeleteSuppression removes the address from the suppression list.
+	DeleteSuppression(clientId string, email string) error
+}
+
+// SweegoClientsApi manages the sub-clients of a client.
+type SweegoClientsApi interface {
+	// ListClients returns all sub-clients of the client.
+	ListClients(clientId string) ([]SweegoClient, error)
+	// GetClient returns a single sub-client.
+	GetClient(clientId string, id string) (SweegoClient, error)
+	// CreateClient adds a sub-client to the client.
+	CreateClient(clientId string, client SweegoClientChangeRequest) (SweegoClient, error)
+	// UpdateClient replaces the settings of the sub-client.
+	UpdateClient(clientId string, id string, client SweegoClientChangeRequest) (SweegoClient, error)
+	// DeleteClient removes the sub-client.
+	DeleteClient(clientId string, id string) error
+}
+
+// SweegoIpPoolsApi manages dedicated IPs, pools of them and the pools domains send through.
+type SweegoIpPoolsApi interface {
+	// ListDedicatedIps returns the dedicated IPs of the client including their warm-up status.
+	ListDedicatedIps(clientId string) ([]SweegoDedicatedIp, error)
+	// ListIpPools returns all IP pools of the client.
+	ListIpPools(clientId string) ([]SweegoIpPool, error)
+	// GetIpPool returns a single IP pool.
+	GetIpPool(clientId string, uuid string) (SweegoIpPool, error)
+	// CreateIpPool adds a pool of dedicated IPs.
+	CreateIpPool(clientId string, pool SweegoIpPoolChangeRequest) (SweegoIpPool, error)
+	// UpdateIpPool replaces the name and IPs of the pool.
+	UpdateIpPool(clientId string, uuid string, pool SweegoIpPoolChangeRequest) (SweegoIpPool, error)
+	// DeleteIpPool removes the pool.
+	DeleteIpPool(clientId string, uuid string) error
+	// GetDomainIpPool returns the pool the domain sends through.
+	GetDomainIpPool(clientId string, domainUuid string) (SweegoDomainIpPoolAssignment, error)
+	// AssignDomainIpPool makes the domain send through the pool.
+	AssignDomainIpPool(clientId string, domainUuid string, assignment SweegoDomainIpPoolAssignment) error
+	// UnassignDomainIpPool removes the pool assignment of the domain.
+	UnassignDomainIpPool(clientId string, domainUuid string) error
+}
+
+// SweegoSendApi sends transactional E-Mails.
+type SweegoSendApi interface {
+	// Send sends a transactional E-Mail and returns the IDs of the sent messages.
+	Send(message SweegoSendRequest) (SweegoSendResponse, error)
+}
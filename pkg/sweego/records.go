@@ -0,0 +1,76 @@
+package sweego
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SweegoRequiredRecord is a DNS record that needs to be published in order to use a domain,
+// normalised so it can be passed to DNS providers without further modification.
+type SweegoRequiredRecord struct {
+	// Purpose of the record, unique per domain: domain, dkim, dkim_next, dmarc, tracking or inbound_<n>
+	Purpose string `json:"purpose"`
+	// Name of the record relative to the domain, without trailing dot
+	Name string `json:"name"`
+	// Fully qualified name of the record, without trailing dot
+	Fqdn string `json:"fqdn"`
+	Type string `json:"type"`
+	// Data of the record, without trailing dot
+	Data string `json:"data"`
+}
+
+// StripTrailingDot removes the trailing dot of fully qualified DNS names (e.g. CNAME targets).
+func StripTrailingDot(value string) string {
+	return strings.TrimSuffix(value, ".")
+}
+
+// RecordFqdn returns the fully qualified name (without trailing dot) of a record with the given name.
+// Names that are already fully qualified are returned as they are.
+func RecordFqdn(name string, domain string) string {
+	name = StripTrailingDot(name)
+	domain = StripTrailingDot(domain)
+
+	if name == "" || name == "@" {
+		return domain
+	}
+	if strings.EqualFold(name, domain) || strings.HasSuffix(strings.ToLower(name), "."+strings.ToLower(domain)) {
+		return name
+	}
+	return fmt.Sprintf("%s.%s", name, domain)
+}
+
+// RequiredRecords returns all DNS records that need to be published for the domain.
+// Records that are not provided by sweego (e.g. no tracking record) are skipped.
+func (details SweegoDomainDetails) RequiredRecords() []SweegoRequiredRecord {
+	records := []SweegoRequiredRecord{}
+	add := func(purpose string, record SweegoDomainRecord) {
+		if record.Type == "" || record.Data == "" {
+			return
+		}
+
+		fqdn := RecordFqdn(record.Name, details.Domain)
+		name := ""
+		if domain := StripTrailingDot(details.Domain); len(fqdn) > len(domain) {
+			name = fqdn[:len(fqdn)-len(domain)-1]
+		}
+
+		records = append(records, SweegoRequiredRecord{
+			Purpose: purpose,
+			Name:    name,
+			Fqdn:    fqdn,
+			Type:    strings.ToUpper(record.Type),
+			Data:    StripTrailingDot(record.Data),
+		})
+	}
+
+	add("domain", details.DomainRecord)
+	add("dkim", details.DkimRecord)
+	add("dkim_next", details.NextDkimRecord)
+	add("dmarc", details.DmarcRecord)
+	add("tracking", details.TrackingRecord)
+	for i, record := range details.InboundRecordList {
+		add(fmt.Sprintf("inbound_%d", i), record)
+	}
+
+	return records
+}
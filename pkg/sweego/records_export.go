@@ -0,0 +1,194 @@
+package sweego
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	RecordFormatBind         = "bind"
+	RecordFormatOctoDnsYaml  = "octodns_yaml"
+	RecordFormatDnsControlJs = "dnscontrol_js"
+	RecordFormatJson         = "json"
+)
+
+// RecordFormats lists all formats supported by RenderRecords.
+var RecordFormats = []string{RecordFormatBind, RecordFormatOctoDnsYaml, RecordFormatDnsControlJs, RecordFormatJson}
+
+// RecordTtl is the TTL used for records in formats that require one.
+const RecordTtl = 3600
+
+// RenderRecords renders the DNS records required by the domain in the given format, so they can be
+// added to DNS setups that are not managed using terraform (e.g. BIND zone files, OctoDNS or DNSControl).
+func (details SweegoDomainDetails) RenderRecords(format string) (string, error) {
+	records := details.RequiredRecords()
+
+	switch format {
+	case RecordFormatBind:
+		return renderBind(details.Domain, records), nil
+	case RecordFormatOctoDnsYaml:
+		return renderOctoDnsYaml(records), nil
+	case RecordFormatDnsControlJs:
+		return renderDnsControlJs(details.Domain, records), nil
+	case RecordFormatJson:
+		return renderJson(records)
+	default:
+		return "", fmt.Errorf("Unknown record format %#v, expected one of: %s", format, strings.Join(RecordFormats, ", "))
+	}
+}
+
+func renderBind(domain string, records []SweegoRequiredRecord) string {
+	var out strings.Builder
+	fmt.Fprintf(&out, "; sweego records for %s\n", StripTrailingDot(domain))
+	for _, record := range records {
+		fmt.Fprintf(&out, "%s.\t%d\tIN\t%s\t%s\n", record.Fqdn, RecordTtl, record.Type, bindData(record))
+	}
+	return out.String()
+}
+
+func bindData(record SweegoRequiredRecord) string {
+	switch record.Type {
+	case "TXT":
+		return quoteTxt(record.Data)
+	case "CNAME", "NS", "PTR", "DNAME", "ALIAS":
+		return record.Data + "."
+	case "MX":
+		preference, exchange := splitMx(record.Data)
+		return fmt.Sprintf("%d %s.", preference, exchange)
+	default:
+		return record.Data
+	}
+}
+
+// quoteTxt quotes TXT record data for zone files, splitting it into multiple strings of
+// at most 255 characters as required by RFC 1035.
+func quoteTxt(data string) string {
+	parts := []string{}
+	for len(data) > 255 {
+		parts = append(parts, data[:255])
+		data = data[255:]
+	}
+	parts = append(parts, data)
+
+	for i, part := range parts {
+		part = strings.ReplaceAll(part, `\`, `\\`)
+		parts[i] = `"` + strings.ReplaceAll(part, `"`, `\"`) + `"`
+	}
+	return strings.Join(parts, " ")
+}
+
+// splitMx splits MX record data (e.g. `10 mx.sweego.io`) into preference and exchange.
+// If the data does not contain a preference, 10 is assumed.
+func splitMx(data string) (int, string) {
+	fields := strings.Fields(data)
+	if len(fields) == 2 {
+		if preference, err := strconv.Atoi(fields[0]); err == nil {
+			return preference, StripTrailingDot(fields[1])
+		}
+	}
+	return 10, StripTrailingDot(data)
+}
+
+func renderOctoDnsYaml(records []SweegoRequiredRecord) string {
+	// OctoDNS groups records by name and type - names with multiple types become lists.
+	names := []string{}
+	types := map[string][]string{}
+	byNameAndType := map[string][]SweegoRequiredRecord{}
+	for _, record := range records {
+		key := record.Name + " " + record.Type
+		if _, ok := types[record.Name]; !ok {
+			names = append(names, record.Name)
+		}
+		if _, ok := byNameAndType[key]; !ok {
+			types[record.Name] = append(types[record.Name], record.Type)
+		}
+		byNameAndType[key] = append(byNameAndType[key], record)
+	}
+
+	var out strings.Builder
+	out.WriteString("---\n")
+	for _, name := range names {
+		fmt.Fprintf(&out, "%s:\n", quoteString(name))
+		indent := "  "
+		if len(types[name]) > 1 {
+			indent = "    "
+		}
+		for _, recordType := range types[name] {
+			prefix := indent
+			if len(types[name]) > 1 {
+				prefix = "  - "
+			}
+			fmt.Fprintf(&out, "%stype: %s\n", prefix, recordType)
+			fmt.Fprintf(&out, "%sttl: %d\n", indent, RecordTtl)
+
+			values := byNameAndType[name+" "+recordType]
+			if len(values) == 1 {
+				fmt.Fprintf(&out, "%svalue:%s", indent, octoDnsValue(values[0], indent+"  "))
+				continue
+			}
+			fmt.Fprintf(&out, "%svalues:\n", indent)
+			for _, value := range values {
+				fmt.Fprintf(&out, "%s-%s", indent, octoDnsValue(value, indent+"  "))
+			}
+		}
+	}
+	return out.String()
+}
+
+// octoDnsValue renders a single value of a record, starting with the rest of the current line.
+func octoDnsValue(record SweegoRequiredRecord, indent string) string {
+	switch record.Type {
+	case "TXT":
+		// OctoDNS requires semicolons in TXT records to be escaped
+		return fmt.Sprintf(" %s\n", quoteString(strings.ReplaceAll(record.Data, ";", `\;`)))
+	case "CNAME", "NS", "PTR", "DNAME", "ALIAS":
+		return fmt.Sprintf(" %s\n", quoteString(record.Data+"."))
+	case "MX":
+		preference, exchange := splitMx(record.Data)
+		return fmt.Sprintf("\n%sexchange: %s\n%spreference: %d\n", indent, quoteString(exchange+"."), indent, preference)
+	default:
+		return fmt.Sprintf(" %s\n", quoteString(record.Data))
+	}
+}
+
+// quoteString quotes the given value as JSON string, which is valid in both YAML and JavaScript.
+func quoteString(value string) string {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+	return strings.TrimSpace(out.String())
+}
+
+func renderDnsControlJs(domain string, records []SweegoRequiredRecord) string {
+	lines := []string{}
+	for _, record := range records {
+		name := record.Name
+		if name == "" {
+			name = "@"
+		}
+
+		switch record.Type {
+		case "MX":
+			preference, exchange := splitMx(record.Data)
+			lines = append(lines, fmt.Sprintf("    MX(%s, %d, %s)", quoteString(name), preference, quoteString(exchange+".")))
+		case "CNAME", "NS", "PTR", "DNAME", "ALIAS":
+			lines = append(lines, fmt.Sprintf("    %s(%s, %s)", record.Type, quoteString(name), quoteString(record.Data+".")))
+		default:
+			lines = append(lines, fmt.Sprintf("    %s(%s, %s)", record.Type, quoteString(name), quoteString(record.Data)))
+		}
+	}
+
+	return fmt.Sprintf("D_EXTEND(%s,\n%s\n);\n", quoteString(StripTrailingDot(domain)), strings.Join(lines, ",\n"))
+}
+
+func renderJson(records []SweegoRequiredRecord) (string, error) {
+	out, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("Cannot serialize records as JSON: %s", err)
+	}
+	return string(out) + "\n", nil
+}
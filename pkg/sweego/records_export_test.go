@@ -0,0 +1,91 @@
+package sweego
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "Update the golden files in testdata")
+
+// exportTestDomain returns a domain whose records cover the special cases of the formats: Trailing dots,
+// TXT data with semicolons, quotes and more than 255 characters, apex records and MX records with and
+// without preference.
+func exportTestDomain() SweegoDomainDetails {
+	return SweegoDomainDetails{
+		Domain:         "example.com.",
+		DomainRecord:   SweegoDomainRecord{Name: "swg", Type: "CNAME", Data: "uuid.sweego.io."},
+		DkimRecord:     SweegoDomainRecord{Name: "swg._domainkey", Type: "cname", Data: "uuid.dkim.sweego.io"},
+		NextDkimRecord: SweegoDomainRecord{Name: "swg2._domainkey.example.com.", Type: "CNAME", Data: "uuid.dkim2.sweego.io."},
+		DmarcRecord:    SweegoDomainRecord{Name: "_dmarc", Type: "TXT", Data: `v=DMARC1; p=none; rua=mailto:"dmarc\reports"@example.com`},
+		TrackingRecord: SweegoDomainRecord{Name: "swg-t", Type: "CNAME", Data: "track.sweego.io."},
+		InboundRecordList: []SweegoDomainRecord{
+			{Name: "", Type: "MX", Data: "10 mx1.sweego.io."},
+			{Name: "@", Type: "MX", Data: "mx2.sweego.io"},
+			{Name: "inbound", Type: "TXT", Data: "v=spf1 " + strings.Repeat("include:spf.sweego.io ", 12) + "-all"},
+		},
+	}
+}
+
+func TestRenderRecords(t *testing.T) {
+	for _, format := range RecordFormats {
+		t.Run(format, func(t *testing.T) {
+			rendered, err := exportTestDomain().RenderRecords(format)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			golden := filepath.Join("testdata", "records_"+format+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(rendered), 0o644); err != nil {
+					t.Fatalf("cannot update golden file: %s", err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("cannot read golden file (run go test with -update in order to create it): %s", err)
+			}
+			if rendered != string(expected) {
+				t.Errorf("records do not match %s (run go test with -update after reviewing the change):\n%s", golden, rendered)
+			}
+		})
+	}
+}
+
+func TestRenderRecordsUnknownFormat(t *testing.T) {
+	if _, err := exportTestDomain().RenderRecords("yaml"); err == nil || !strings.Contains(err.Error(), strings.Join(RecordFormats, ", ")) {
+		t.Errorf("expected an error listing the supported formats, got %v", err)
+	}
+}
+
+func TestQuoteTxt(t *testing.T) {
+	tests := map[string]string{
+		"v=spf1 -all":            `"v=spf1 -all"`,
+		`quote " slash \`:        `"quote \" slash \\"`,
+		strings.Repeat("a", 256): `"` + strings.Repeat("a", 255) + `" "a"`,
+	}
+	for data, expected := range tests {
+		if actual := quoteTxt(data); actual != expected {
+			t.Errorf("quoteTxt(%#v) = %#v, expected %#v", data, actual, expected)
+		}
+	}
+}
+
+func TestSplitMx(t *testing.T) {
+	tests := []struct {
+		data       string
+		preference int
+		exchange   string
+	}{
+		{"10 mx.sweego.io.", 10, "mx.sweego.io"},
+		{"20 mx.sweego.io", 20, "mx.sweego.io"},
+		{"mx.sweego.io.", 10, "mx.sweego.io"},
+	}
+	for _, test := range tests {
+		if preference, exchange := splitMx(test.data); preference != test.preference || exchange != test.exchange {
+			t.Errorf("splitMx(%#v) = %d, %#v, expected %d, %#v", test.data, preference, exchange, test.preference, test.exchange)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package sweego
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripTrailingDot(t *testing.T) {
+	tests := map[string]string{
+		"abc.sweego.io.": "abc.sweego.io",
+		"abc.sweego.io":  "abc.sweego.io",
+		"":               "",
+		".":              "",
+	}
+	for value, expected := range tests {
+		if actual := StripTrailingDot(value); actual != expected {
+			t.Errorf("StripTrailingDot(%#v) = %#v, expected %#v", value, actual, expected)
+		}
+	}
+}
+
+func TestRecordFqdn(t *testing.T) {
+	tests := []struct {
+		name     string
+		domain   string
+		expected string
+	}{
+		{"", "example.com", "example.com"},
+		{"@", "example.com.", "example.com"},
+		{"swg", "example.com", "swg.example.com"},
+		{"swg._domainkey", "example.com.", "swg._domainkey.example.com"},
+		{"swg", "mail.example.com", "swg.mail.example.com"},
+		{"swg.example.com.", "example.com", "swg.example.com"},
+		{"swg.example.com", "example.com", "swg.example.com"},
+		{"SWG.Example.COM", "example.com", "SWG.Example.COM"},
+		{"example.com.", "example.com", "example.com"},
+		{"swg.mail.example.com", "mail.example.com.", "swg.mail.example.com"},
+		{"notexample.com", "example.com", "notexample.com.example.com"},
+	}
+	for _, test := range tests {
+		if actual := RecordFqdn(test.name, test.domain); actual != test.expected {
+			t.Errorf("RecordFqdn(%#v, %#v) = %#v, expected %#v", test.name, test.domain, actual, test.expected)
+		}
+	}
+}
+
+func TestRequiredRecords(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  SweegoDomainDetails
+		expected []SweegoRequiredRecord
+	}{
+		{
+			name: "apex domain",
+			details: SweegoDomainDetails{
+				Domain:       "example.com",
+				DomainRecord: SweegoDomainRecord{Name: "swg", Type: "cname", Data: "uuid.domains.sweego.io."},
+				DmarcRecord:  SweegoDomainRecord{Name: "_dmarc.example.com.", Type: "TXT", Data: "v=DMARC1; p=none"},
+				InboundRecordList: []SweegoDomainRecord{
+					{Name: "@", Type: "MX", Data: "10 mx1.sweego.io."},
+					{Name: "example.com.", Type: "MX", Data: "20 mx2.sweego.io."},
+				},
+			},
+			expected: []SweegoRequiredRecord{
+				{Purpose: "domain", Name: "swg", Fqdn: "swg.example.com", Type: "CNAME", Data: "uuid.domains.sweego.io"},
+				{Purpose: "dmarc", Name: "_dmarc", Fqdn: "_dmarc.example.com", Type: "TXT", Data: "v=DMARC1; p=none"},
+				{Purpose: "inbound_0", Name: "", Fqdn: "example.com", Type: "MX", Data: "10 mx1.sweego.io"},
+				{Purpose: "inbound_1", Name: "", Fqdn: "example.com", Type: "MX", Data: "20 mx2.sweego.io"},
+			},
+		},
+		{
+			name: "subdomain",
+			details: SweegoDomainDetails{
+				Domain:         "mail.example.com.",
+				DomainRecord:   SweegoDomainRecord{Name: "swg", Type: "CNAME", Data: "uuid.domains.sweego.io."},
+				DkimRecord:     SweegoDomainRecord{Name: "swg._domainkey.mail.example.com", Type: "CNAME", Data: "uuid.dkim.sweego.io."},
+				NextDkimRecord: SweegoDomainRecord{Name: "swg2._domainkey", Type: "CNAME", Data: "uuid.dkim2.sweego.io"},
+				TrackingRecord: SweegoDomainRecord{Name: "t.mail.example.com.", Type: "CNAME", Data: "track.sweego.io."},
+			},
+			expected: []SweegoRequiredRecord{
+				{Purpose: "domain", Name: "swg", Fqdn: "swg.mail.example.com", Type: "CNAME", Data: "uuid.domains.sweego.io"},
+				{Purpose: "dkim", Name: "swg._domainkey", Fqdn: "swg._domainkey.mail.example.com", Type: "CNAME", Data: "uuid.dkim.sweego.io"},
+				{Purpose: "dkim_next", Name: "swg2._domainkey", Fqdn: "swg2._domainkey.mail.example.com", Type: "CNAME", Data: "uuid.dkim2.sweego.io"},
+				{Purpose: "tracking", Name: "t", Fqdn: "t.mail.example.com", Type: "CNAME", Data: "track.sweego.io"},
+			},
+		},
+		{
+			name: "records not provided by sweego",
+			details: SweegoDomainDetails{
+				Domain:         "example.com",
+				DomainRecord:   SweegoDomainRecord{Name: "swg", Type: "", Data: "uuid.domains.sweego.io."},
+				TrackingRecord: SweegoDomainRecord{Name: "t", Type: "CNAME", Data: ""},
+			},
+			expected: []SweegoRequiredRecord{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := test.details.RequiredRecords(); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("RequiredRecords() = %#v, expected %#v", actual, test.expected)
+			}
+		})
+	}
+}
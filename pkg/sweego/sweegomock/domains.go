@@ -0,0 +1,306 @@
+// Package sweegomock contains in-memory implementations of the sweego API interfaces,
+// in order to test code depending on them without HTTP.
+package sweegomock
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ sweego.SweegoDomainsApi = &DomainsApi{}
+
+// Call is a single call of a mock method.
+type Call struct {
+	Method   string
+	ClientId string
+	Args     []any
+}
+
+// DomainsApi is an in-memory implementation of sweego.SweegoDomainsApi. Domains are stored per UUID,
+// records of created domains are derived from the UUID deterministically (see DomainDetails). Client IDs
+// are recorded in Calls, but do not separate domains.
+//
+// All fields may be modified by tests directly, access is synchronised for concurrent calls.
+type DomainsApi struct {
+	mutex sync.Mutex
+
+	Domains map[string]sweego.SweegoDomainDetails
+	// CheckResults are returned by Check. Domains without a check result are reported as fully verified.
+	// Check applies the result to the verification status of the domain.
+	CheckResults map[string]sweego.SweegoDomainCheckResult
+	// Errors are returned instead of the result by the method with the given name (e.g. "GetDomain").
+	Errors map[string]error
+	// Calls contains the calls in order. Only the latest MaxCalls calls are kept, 0 keeps all calls.
+	Calls    []Call
+	MaxCalls int
+
+	created int
+	rotated int
+}
+
+// DefaultMaxCalls is the number of calls NewDomainsApi keeps in Calls, so long-running mocks (e.g. the
+// mock mode of the provider) do not grow indefinitely.
+const DefaultMaxCalls = 1000
+
+func NewDomainsApi() *DomainsApi {
+	return &DomainsApi{
+		Domains:      map[string]sweego.SweegoDomainDetails{},
+		CheckResults: map[string]sweego.SweegoDomainCheckResult{},
+		Errors:       map[string]error{},
+		MaxCalls:     DefaultMaxCalls,
+	}
+}
+
+// ResetCalls returns the calls recorded so far and clears Calls, so tests can check the calls of a single step.
+func (api *DomainsApi) ResetCalls() []Call {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	calls := api.Calls
+	api.Calls = nil
+	return calls
+}
+
+// NotFound returns the error the API returns for unknown objects, so sweego.IsNotFound can detect it.
+func NotFound(method string, path string) error {
+	return &sweego.SweegoHttpError{Method: method, Url: path, StatusCode: http.StatusNotFound, Body: []byte(`{"detail":"Not Found"}`)}
+}
+
+// DomainUuid returns the deterministic UUID of the n-th (starting at 1) domain created.
+func DomainUuid(n int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", n)
+}
+
+// DomainDetails returns a domain with deterministic records derived from its UUID, resembling the records
+// sweego provides for new domains.
+func DomainDetails(uuid string, domain string) sweego.SweegoDomainDetails {
+	return sweego.SweegoDomainDetails{
+		Uuid:   uuid,
+		Domain: domain,
+		DomainRecord: sweego.SweegoDomainRecord{
+			Name: "swg",
+			Type: "CNAME",
+			Data: fmt.Sprintf("%s.domains.sweego.io.", uuid),
+		},
+		DkimRecord: sweego.SweegoDomainRecord{
+			Name: "swg._domainkey",
+			Type: "CNAME",
+			Data: fmt.Sprintf("%s.dkim.sweego.io.", uuid),
+		},
+		DmarcRecord: sweego.SweegoDomainRecord{
+			Name: "_dmarc",
+			Type: "TXT",
+			Data: "v=DMARC1; p=none",
+		},
+		TrackingRecord: sweego.SweegoDomainRecord{
+			Name: "swg-t",
+			Type: "CNAME",
+			Data: "tracking.sweego.io.",
+		},
+		InboundRecordList: []sweego.SweegoDomainRecord{
+			{Name: "@", Type: "MX", Data: "10 mx.sweego.io."},
+		},
+	}
+}
+
+// record registers the call and returns the configured error of the method, if any.
+func (api *DomainsApi) record(method string, clientId string, args ...any) error {
+	api.Calls = append(api.Calls, Call{Method: method, ClientId: clientId, Args: args})
+	if api.MaxCalls > 0 && len(api.Calls) > api.MaxCalls {
+		// The dropped calls are released once append reallocates the slice
+		api.Calls = api.Calls[len(api.Calls)-api.MaxCalls:]
+	}
+	return api.Errors[method]
+}
+
+func (api *DomainsApi) domain(method string, uuid string) (sweego.SweegoDomainDetails, error) {
+	domain, ok := api.Domains[uuid]
+	if !ok {
+		return sweego.SweegoDomainDetails{}, NotFound(method, "domains/"+uuid)
+	}
+	return domain, nil
+}
+
+func (api *DomainsApi) ListDomains(clientId string) ([]sweego.SweegoDomainListInformation, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("ListDomains", clientId); err != nil {
+		return nil, err
+	}
+
+	domains := []sweego.SweegoDomainListInformation{}
+	for _, domain := range api.Domains {
+		domains = append(domains, sweego.SweegoDomainListInformation{
+			Uuid:                 domain.Uuid,
+			Domain:               domain.Domain,
+			IsVerified:           domain.IsVerified,
+			TrackingOpenEnabled:  domain.TrackingOpenEnabled,
+			TrackingClickEnabled: domain.TrackingClickEnabled,
+			TrackingSubdomain:    domain.TrackingSubdomain,
+			TrackingHttpsEnabled: domain.TrackingHttpsEnabled,
+		})
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Uuid < domains[j].Uuid })
+	return domains, nil
+}
+
+func (api *DomainsApi) GetDomain(clientId string, uuid string) (sweego.SweegoDomainDetails, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("GetDomain", clientId, uuid); err != nil {
+		return sweego.SweegoDomainDetails{}, err
+	}
+
+	return api.domain("GET", uuid)
+}
+
+func (api *DomainsApi) CreateDomain(clientId string, domain string) (sweego.SweegoDomainDetails, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("CreateDomain", clientId, domain); err != nil {
+		return sweego.SweegoDomainDetails{}, err
+	}
+
+	api.created++
+	details := DomainDetails(DomainUuid(api.created), domain)
+	api.Domains[details.Uuid] = details
+	return details, nil
+}
+
+func (api *DomainsApi) DeleteDomain(clientId string, uuid string) error {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("DeleteDomain", clientId, uuid); err != nil {
+		return err
+	}
+
+	if _, err := api.domain("DELETE", uuid); err != nil {
+		return err
+	}
+	delete(api.Domains, uuid)
+	return nil
+}
+
+func (api *DomainsApi) Check(clientId string, uuid string) (sweego.SweegoDomainCheckResult, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("Check", clientId, uuid); err != nil {
+		return sweego.SweegoDomainCheckResult{}, err
+	}
+
+	domain, err := api.domain("POST", uuid)
+	if err != nil {
+		return sweego.SweegoDomainCheckResult{}, err
+	}
+	result, ok := api.CheckResults[uuid]
+	if !ok {
+		verified := sweego.SweegoDomainCheckSingleResult{Verified: true}
+		result = sweego.SweegoDomainCheckResult{
+			DomainRecord:   verified,
+			DkimRecord:     verified,
+			DmarcRecord:    verified,
+			TrackingRecord: verified,
+		}
+		for range domain.InboundRecordList {
+			result.InboundRecordList = append(result.InboundRecordList, verified)
+		}
+	}
+
+	// Like the API, the verification status returned by GetDomain is updated by checks
+	domain.DomainRecord.Verified = result.DomainRecord.Verified
+	domain.DkimRecord.Verified = result.DkimRecord.Verified
+	domain.DmarcRecord.Verified = result.DmarcRecord.Verified
+	domain.TrackingRecord.Verified = result.TrackingRecord.Verified
+	domain.IsVerified = true
+	for _, record := range result.Records() {
+		domain.IsVerified = domain.IsVerified && record.Verified
+	}
+	domain.InboundRecordList = append([]sweego.SweegoDomainRecord{}, domain.InboundRecordList...)
+	for i := range domain.InboundRecordList {
+		domain.InboundRecordList[i].Verified = i < len(result.InboundRecordList) && result.InboundRecordList[i].Verified
+	}
+	api.Domains[uuid] = domain
+	return result, nil
+}
+
+func (api *DomainsApi) UpdateTracking(clientId string, uuid string, tracking sweego.SweegoTrackingChangeRequest) error {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("UpdateTracking", clientId, uuid, tracking); err != nil {
+		return err
+	}
+
+	domain, err := api.domain("PUT", uuid)
+	if err != nil {
+		return err
+	}
+	domain.TrackingOpenEnabled = tracking.OpenTrackingEnabled
+	domain.TrackingClickEnabled = tracking.ClickTrackingEnabled
+	if tracking.HttpsEnabled != nil {
+		domain.TrackingHttpsEnabled = *tracking.HttpsEnabled
+	}
+	if tracking.TrackingSubdomain != nil {
+		domain.TrackingSubdomain = *tracking.TrackingSubdomain
+	}
+
+	// Like the API, the tracking record is published at the tracking subdomain and needs to be verified again
+	// if it changes
+	name := DomainDetails(uuid, domain.Domain).TrackingRecord.Name
+	if domain.TrackingSubdomain != "" {
+		name = strings.TrimSuffix(domain.TrackingSubdomain, "."+domain.Domain)
+	}
+	if name != domain.TrackingRecord.Name {
+		domain.TrackingRecord.Name = name
+		domain.TrackingRecord.Verified = false
+		domain.IsVerified = false
+	}
+	api.Domains[uuid] = domain
+	return nil
+}
+
+func (api *DomainsApi) RotateDkim(clientId string, uuid string) (sweego.SweegoDomainRecord, error) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("RotateDkim", clientId, uuid); err != nil {
+		return sweego.SweegoDomainRecord{}, err
+	}
+
+	domain, err := api.domain("POST", uuid)
+	if err != nil {
+		return sweego.SweegoDomainRecord{}, err
+	}
+	api.rotated++
+	domain.NextDkimRecord = sweego.SweegoDomainRecord{
+		Name: fmt.Sprintf("swg%d._domainkey", api.rotated),
+		Type: "CNAME",
+		Data: fmt.Sprintf("%s.dkim%d.sweego.io.", uuid, api.rotated),
+	}
+	api.Domains[uuid] = domain
+	return domain.NextDkimRecord, nil
+}
+
+// CompleteDkimRotation replaces the DKIM record by the next one. Like the API, it fails if the next
+// record is not verified - set NextDkimRecord.Verified of the domain in order to complete rotations.
+func (api *DomainsApi) CompleteDkimRotation(clientId string, uuid string) error {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	if err := api.record("CompleteDkimRotation", clientId, uuid); err != nil {
+		return err
+	}
+
+	domain, err := api.domain("POST", uuid)
+	if err != nil {
+		return err
+	}
+	if domain.NextDkimRecord.Data == "" || !domain.NextDkimRecord.Verified {
+		return &sweego.SweegoHttpError{Method: "POST", Url: "domains/" + uuid + "/dkim/rotation/complete", StatusCode: http.StatusConflict, Body: []byte(`{"detail":"No verified DKIM rotation in progress"}`)}
+	}
+	domain.DkimRecord = domain.NextDkimRecord
+	domain.NextDkimRecord = sweego.SweegoDomainRecord{}
+	api.Domains[uuid] = domain
+	return nil
+}
@@ -0,0 +1,75 @@
+package sweegomock
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// EnvStateFile is the environment variable naming the file the mock mode of the provider keeps its state in.
+const EnvStateFile = "SWEEGO_MOCK_STATE_FILE"
+
+// domainsState is the content of the state file written by Save.
+type domainsState struct {
+	Domains map[string]sweego.SweegoDomainDetails `json:"domains"`
+	Created int                                   `json:"created"`
+	Rotated int                                   `json:"rotated"`
+}
+
+// Save writes the domains to the file, so they can be restored by another process using Load. Check results,
+// errors and calls are not saved.
+func (api *DomainsApi) Save(path string) error {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	encoded, err := json.MarshalIndent(domainsState{Domains: api.Domains, Created: api.created, Rotated: api.rotated}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Replace the file atomically, so an interrupted process does not leave a truncated state behind
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(encoded); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// Load replaces the domains by those saved to the file using Save. A missing file is not an error, the
+// domains are kept then.
+func (api *DomainsApi) Load(path string) error {
+	encoded, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var state domainsState
+	if err := json.Unmarshal(encoded, &state); err != nil {
+		return err
+	}
+	if state.Domains == nil {
+		state.Domains = map[string]sweego.SweegoDomainDetails{}
+	}
+
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	api.Domains = state.Domains
+	api.created = state.Created
+	api.rotated = state.Rotated
+	return nil
+}
@@ -0,0 +1,82 @@
+package sweegomock
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+// newPersistentApi returns a client whose mock keeps its state in the file, like a new provider process.
+func newPersistentApi(t *testing.T, stateFile string) *sweego.SweegoApi {
+	t.Helper()
+
+	transport := NewTransport(NewDomainsApi())
+	if err := transport.Domains.Load(stateFile); err != nil {
+		t.Fatalf("cannot load state: %s", err)
+	}
+	transport.StateFile = stateFile
+	return sweego.NewSweegoApi("key", "client", sweego.WithHttpClient(&http.Client{Transport: transport}), sweego.WithLogger(discardLogger{}))
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Info(string)  {}
+func (discardLogger) Error(string) {}
+func (discardLogger) Debug(string) {}
+
+func TestTransportStateFile(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "mock.json")
+
+	first := newPersistentApi(t, stateFile)
+	if _, err := first.ListDomains(""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := os.Stat(stateFile); err == nil {
+		t.Errorf("expected reading not to write the state file")
+	}
+	created, err := first.CreateDomain("", "example.com")
+	if err != nil {
+		t.Fatalf("cannot create domain: %s", err)
+	}
+
+	second := newPersistentApi(t, stateFile)
+	domain, err := second.GetDomain("", created.Uuid)
+	if err != nil {
+		t.Fatalf("expected the domain to be restored, got %s", err)
+	}
+	if domain.Domain != "example.com" || domain.DkimRecord.Data != created.DkimRecord.Data {
+		t.Errorf("unexpected domain %#v", domain)
+	}
+	next, err := second.CreateDomain("", "example.org")
+	if err != nil {
+		t.Fatalf("cannot create domain: %s", err)
+	}
+	if next.Uuid != DomainUuid(2) {
+		t.Errorf("expected UUIDs to continue after restoring, got %s", next.Uuid)
+	}
+	if err := second.DeleteDomain("", created.Uuid); err != nil {
+		t.Fatalf("cannot delete domain: %s", err)
+	}
+
+	third := newPersistentApi(t, stateFile)
+	if _, err := third.GetDomain("", created.Uuid); !sweego.IsNotFound(err) {
+		t.Errorf("expected the deleted domain to be gone, got %v", err)
+	}
+	domains, err := third.ListDomains("")
+	if err != nil || len(domains) != 1 || domains[0].Uuid != next.Uuid {
+		t.Errorf("unexpected domains %#v (%v)", domains, err)
+	}
+}
+
+func TestLoadInvalidStateFile(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "mock.json")
+	if err := os.WriteFile(stateFile, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewDomainsApi().Load(stateFile); err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,150 @@
+package sweegomock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/j6s/terraform-provider-sweego-provider/pkg/sweego"
+)
+
+var _ http.RoundTripper = &Transport{}
+
+// Transport answers requests of sweego.SweegoApi in-process using a DomainsApi, so the real client
+// (including serialization and error handling) can be used without a sweego account, e.g. by passing
+// &http.Client{Transport: NewTransport(NewDomainsApi())} to sweego.WithHttpClient. The host of the
+// request is ignored.
+//
+// Only the domain endpoints are implemented, all other endpoints respond with 501 Not Implemented.
+type Transport struct {
+	Domains *DomainsApi
+	// StateFile is the file the domains are saved to (see DomainsApi.Save) after every change, if set.
+	StateFile string
+}
+
+func NewTransport(domains *DomainsApi) *Transport {
+	return &Transport{Domains: domains}
+}
+
+func (transport *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := transport.handle(request.Method, strings.Split(strings.Trim(request.URL.Path, "/"), "/"), body)
+	if err == nil && transport.StateFile != "" && request.Method != http.MethodGet {
+		if err = transport.Domains.Save(transport.StateFile); err != nil {
+			err = fmt.Errorf("Cannot save the state to %s: %s", transport.StateFile, err)
+		}
+	}
+	if err != nil {
+		return errorResponse(request, err), nil
+	}
+	if result == nil {
+		return response(request, http.StatusNoContent, nil), nil
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	return response(request, http.StatusOK, encoded), nil
+}
+
+// handle routes requests to clients/{client_id}/domains[/{uuid}[/...]] to the DomainsApi. A nil result
+// is answered without body.
+func (transport *Transport) handle(method string, segments []string, body []byte) (any, error) {
+	if len(segments) < 3 || segments[0] != "clients" || segments[2] != "domains" {
+		return nil, notImplemented(method, segments)
+	}
+	clientId := segments[1]
+	domains := transport.Domains
+
+	if len(segments) == 3 {
+		switch method {
+		case http.MethodGet:
+			return domains.ListDomains(clientId)
+		case http.MethodPost:
+			var request struct {
+				Domain string `json:"domain"`
+			}
+			if err := json.Unmarshal(body, &request); err != nil {
+				return nil, badRequest(method, segments, err)
+			}
+			return domains.CreateDomain(clientId, request.Domain)
+		}
+		return nil, notImplemented(method, segments)
+	}
+
+	uuid := segments[3]
+	switch method + " " + strings.Join(segments[4:], "/") {
+	case "GET ":
+		return domains.GetDomain(clientId, uuid)
+	case "DELETE ":
+		return nil, domains.DeleteDomain(clientId, uuid)
+	case "POST check":
+		return domains.Check(clientId, uuid)
+	case "PUT tracking":
+		var tracking sweego.SweegoTrackingChangeRequest
+		if err := json.Unmarshal(body, &tracking); err != nil {
+			return nil, badRequest(method, segments, err)
+		}
+		return nil, domains.UpdateTracking(clientId, uuid, tracking)
+	case "POST dkim/rotation":
+		return domains.RotateDkim(clientId, uuid)
+	case "POST dkim/rotation/complete":
+		return nil, domains.CompleteDkimRotation(clientId, uuid)
+	}
+	return nil, notImplemented(method, segments)
+}
+
+func notImplemented(method string, segments []string) error {
+	return &sweego.SweegoHttpError{
+		Method:     method,
+		Url:        strings.Join(segments, "/"),
+		StatusCode: http.StatusNotImplemented,
+		Body:       []byte(`{"detail":"Not implemented by sweegomock"}`),
+	}
+}
+
+func badRequest(method string, segments []string, err error) error {
+	body, _ := json.Marshal(map[string]string{"detail": err.Error()})
+	return &sweego.SweegoHttpError{Method: method, Url: strings.Join(segments, "/"), StatusCode: http.StatusBadRequest, Body: body}
+}
+
+// errorResponse answers with the status code and body of API errors (e.g. NotFound) and 500 otherwise.
+func errorResponse(request *http.Request, err error) *http.Response {
+	var httpErr *sweego.SweegoHttpError
+	if errors.As(err, &httpErr) {
+		return response(request, httpErr.StatusCode, httpErr.Body)
+	}
+	body, _ := json.Marshal(map[string]string{"detail": fmt.Sprint(err)})
+	return response(request, http.StatusInternalServerError, body)
+}
+
+func response(request *http.Request, statusCode int, body []byte) *http.Response {
+	header := http.Header{}
+	if body != nil {
+		header.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}